@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ecrLifecycleEvaluateImageAttrTypes = map[string]attr.Type{
+	"image_digest":    types.StringType,
+	"image_pushed_at": types.StringType,
+	"image_tags":      types.ListType{ElemType: types.StringType},
+}
+
+var ecrLifecycleEvaluateResultAttrTypes = map[string]attr.Type{
+	"image_digest":  types.StringType,
+	"image_tags":    types.ListType{ElemType: types.StringType},
+	"rule_priority": types.Int64Type,
+}
+
+var _ function.Function = ecrLifecycleEvaluateFunction{}
+
+func NewECRLifecycleEvaluateFunction() function.Function {
+	return &ecrLifecycleEvaluateFunction{}
+}
+
+type ecrLifecycleEvaluateFunction struct{}
+
+func (f ecrLifecycleEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_lifecycle_evaluate"
+}
+
+func (f ecrLifecycleEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "ecr_lifecycle_evaluate Function",
+		MarkdownDescription: "Evaluates an ECR lifecycle policy against a list of images and returns the images that would be expired",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "ECR lifecycle policy JSON document",
+			},
+			function.ListParameter{
+				Name:                "images",
+				MarkdownDescription: "Images to evaluate, each with `image_digest`, `image_tags` and `image_pushed_at` (RFC3339 or Unix epoch seconds) attributes",
+				ElementType: types.ObjectType{
+					AttrTypes: ecrLifecycleEvaluateImageAttrTypes,
+				},
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "now",
+			MarkdownDescription: "Optional RFC3339 timestamp used as the current time for `sinceImagePushed` rules",
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: ecrLifecycleEvaluateResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f ecrLifecycleEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy string
+	var images []ecrLifecycleImage
+	var now []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy, &images, &now))
+	if resp.Error != nil {
+		return
+	}
+
+	evaluatedAt := time.Now()
+	switch len(now) {
+	case 0:
+	case 1:
+		t, err := time.Parse(time.RFC3339, now[0])
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("parsing now: %s", err))
+			return
+		}
+		evaluatedAt = t
+	default:
+		resp.Error = function.NewArgumentFuncError(2, "at most one now argument may be specified")
+		return
+	}
+
+	expired, err := ecrLifecycleEvaluate(policy, images, evaluatedAt)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ecrLifecycleEvaluateResultAttrTypes}, expired)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type ecrLifecycleImage struct {
+	ImageDigest   string   `tfsdk:"image_digest"`
+	ImagePushedAt string   `tfsdk:"image_pushed_at"`
+	ImageTags     []string `tfsdk:"image_tags"`
+}
+
+type ecrLifecycleExpiredImage struct {
+	ImageDigest  string   `tfsdk:"image_digest"`
+	ImageTags    []string `tfsdk:"image_tags"`
+	RulePriority int64    `tfsdk:"rule_priority"`
+}
+
+type ecrLifecyclePolicy struct {
+	Rules []ecrLifecycleRule `json:"rules"`
+}
+
+type ecrLifecycleRule struct {
+	RulePriority int64                     `json:"rulePriority"`
+	Selection    ecrLifecycleRuleSelection `json:"selection"`
+	Action       struct {
+		Type string `json:"type"`
+	} `json:"action"`
+}
+
+type ecrLifecycleRuleSelection struct {
+	TagStatus      string   `json:"tagStatus"`
+	TagPrefixList  []string `json:"tagPrefixList"`
+	TagPatternList []string `json:"tagPatternList"`
+	CountType      string   `json:"countType"`
+	CountUnit      string   `json:"countUnit"`
+	CountNumber    int64    `json:"countNumber"`
+}
+
+// parseECRLifecycleImagePushedAt parses an RFC3339 timestamp or a number of seconds since the Unix epoch,
+// the representation exported by the aws_ecr_image and aws_ecr_lifecycle_policy_preview data sources.
+// Terraform converts numbers passed as image_pushed_at to strings.
+func parseECRLifecycleImagePushedAt(v string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+
+	return time.Parse(time.RFC3339, v)
+}
+
+// ecrLifecycleEvaluate applies the rules of an ECR lifecycle policy to the specified images.
+// Rules are evaluated in ascending priority order and an image selected by a rule
+// cannot be expired by a rule with a lower priority, matching the service's behavior.
+func ecrLifecycleEvaluate(policy string, images []ecrLifecycleImage, now time.Time) ([]ecrLifecycleExpiredImage, error) {
+	var p ecrLifecyclePolicy
+	if err := json.Unmarshal([]byte(policy), &p); err != nil {
+		return nil, fmt.Errorf("parsing lifecycle policy: %w", err)
+	}
+
+	rules := slices.Clone(p.Rules)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].RulePriority < rules[j].RulePriority
+	})
+
+	pushedAt := make([]time.Time, len(images))
+	for i, image := range images {
+		t, err := parseECRLifecycleImagePushedAt(image.ImagePushedAt)
+		if err != nil {
+			return nil, fmt.Errorf("parsing image_pushed_at for image (%s): %w", image.ImageDigest, err)
+		}
+		pushedAt[i] = t
+	}
+
+	claimed := make([]bool, len(images))
+	expiredBy := make([]int64, len(images))
+
+	for _, rule := range rules {
+		if v := rule.Action.Type; v != "" && v != "expire" {
+			return nil, fmt.Errorf("rule (%d): unsupported action type: %s", rule.RulePriority, v)
+		}
+
+		var selected []int
+		for i, image := range images {
+			if claimed[i] {
+				continue
+			}
+
+			ok, err := rule.Selection.matches(image.ImageTags)
+			if err != nil {
+				return nil, fmt.Errorf("rule (%d): %w", rule.RulePriority, err)
+			}
+
+			if ok {
+				selected = append(selected, i)
+				claimed[i] = true
+			}
+		}
+
+		switch rule.Selection.CountType {
+		case "imageCountMoreThan":
+			// Keep the newest images.
+			sort.SliceStable(selected, func(i, j int) bool {
+				return pushedAt[selected[i]].After(pushedAt[selected[j]])
+			})
+
+			for n, i := range selected {
+				if int64(n) >= rule.Selection.CountNumber {
+					expiredBy[i] = rule.RulePriority
+				}
+			}
+		case "sinceImagePushed":
+			if v := rule.Selection.CountUnit; v != "days" {
+				return nil, fmt.Errorf("rule (%d): unsupported count unit: %s", rule.RulePriority, v)
+			}
+
+			cutoff := now.AddDate(0, 0, -int(rule.Selection.CountNumber))
+			for _, i := range selected {
+				if pushedAt[i].Before(cutoff) {
+					expiredBy[i] = rule.RulePriority
+				}
+			}
+		default:
+			return nil, fmt.Errorf("rule (%d): unsupported count type: %s", rule.RulePriority, rule.Selection.CountType)
+		}
+	}
+
+	expired := make([]ecrLifecycleExpiredImage, 0)
+	for i, image := range images {
+		if expiredBy[i] == 0 {
+			continue
+		}
+
+		tags := image.ImageTags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		expired = append(expired, ecrLifecycleExpiredImage{
+			ImageDigest:  image.ImageDigest,
+			ImageTags:    tags,
+			RulePriority: expiredBy[i],
+		})
+	}
+
+	return expired, nil
+}
+
+func (s ecrLifecycleRuleSelection) matches(tags []string) (bool, error) {
+	switch s.TagStatus {
+	case "any":
+		return true, nil
+	case "untagged":
+		return len(tags) == 0, nil
+	case "tagged":
+		if len(tags) == 0 {
+			return false, nil
+		}
+
+		// Every prefix or pattern must match at least one of the image's tags.
+		for _, prefix := range s.TagPrefixList {
+			if !slices.ContainsFunc(tags, func(tag string) bool { return strings.HasPrefix(tag, prefix) }) {
+				return false, nil
+			}
+		}
+
+		for _, pattern := range s.TagPatternList {
+			if !slices.ContainsFunc(tags, func(tag string) bool { return ecrLifecycleWildcardMatch(pattern, tag) }) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported tag status: %s", s.TagStatus)
+	}
+}
+
+// ecrLifecycleWildcardMatch reports whether s matches pattern, where '*' matches any sequence of characters.
+func ecrLifecycleWildcardMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+
+	return strings.HasSuffix(s, last)
+}
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECRLifecycleEvaluateFunction_imageCountMoreThan(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRLifecycleEvaluateFunctionConfig(`{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "selection": {
+        "tagStatus": "tagged",
+        "tagPrefixList": ["v"],
+        "countType": "imageCountMoreThan",
+        "countNumber": 1
+      },
+      "action": {"type": "expire"}
+    },
+    {
+      "rulePriority": 2,
+      "selection": {
+        "tagStatus": "any",
+        "countType": "imageCountMoreThan",
+        "countNumber": 1
+      },
+      "action": {"type": "expire"}
+    }
+  ]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("digests", "sha256:v1,sha256:dev1,sha256:untagged1"),
+					resource.TestCheckOutput("priorities", "1,2,2"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRLifecycleEvaluateFunction_sinceImagePushed(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRLifecycleEvaluateFunctionConfig(`{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 7
+      },
+      "action": {"type": "expire"}
+    },
+    {
+      "rulePriority": 2,
+      "selection": {
+        "tagStatus": "tagged",
+        "tagPatternList": ["dev-*"],
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 3
+      },
+      "action": {"type": "expire"}
+    }
+  ]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("digests", "sha256:dev1,sha256:untagged1"),
+					resource.TestCheckOutput("priorities", "2,1"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRLifecycleEvaluateFunction_unixTimestamps(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRLifecycleEvaluateFunctionConfig_unixTimestamps(`{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 7
+      },
+      "action": {"type": "expire"}
+    },
+    {
+      "rulePriority": 2,
+      "selection": {
+        "tagStatus": "tagged",
+        "tagPatternList": ["dev-*"],
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 3
+      },
+      "action": {"type": "expire"}
+    }
+  ]
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("digests", "sha256:dev1,sha256:untagged1"),
+					resource.TestCheckOutput("priorities", "2,1"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRLifecycleEvaluateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRLifecycleEvaluateFunctionConfig(`{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "selection": {
+        "tagStatus": "sometimes",
+        "countType": "imageCountMoreThan",
+        "countNumber": 1
+      },
+      "action": {"type": "expire"}
+    }
+  ]
+}`),
+				ExpectError: regexache.MustCompile("unsupported tag status: sometimes"),
+			},
+		},
+	})
+}
+
+func testECRLifecycleEvaluateFunctionConfig(policy string) string {
+	return fmt.Sprintf(`
+locals {
+  images = [
+    {
+      image_digest    = "sha256:v2"
+      image_tags      = ["v2", "latest"]
+      image_pushed_at = "2024-05-10T00:00:00Z"
+    },
+    {
+      image_digest    = "sha256:v1"
+      image_tags      = ["v1"]
+      image_pushed_at = "2024-05-01T00:00:00Z"
+    },
+    {
+      image_digest    = "sha256:dev2"
+      image_tags      = ["dev-2"]
+      image_pushed_at = "2024-05-09T00:00:00Z"
+    },
+    {
+      image_digest    = "sha256:dev1"
+      image_tags      = ["dev-1"]
+      image_pushed_at = "2024-05-05T00:00:00Z"
+    },
+    {
+      image_digest    = "sha256:untagged1"
+      image_tags      = []
+      image_pushed_at = "2024-04-01T00:00:00Z"
+    },
+  ]
+
+  expired = provider::aws::ecr_lifecycle_evaluate(%[1]q, local.images, "2024-05-10T12:00:00Z")
+}
+
+output "digests" {
+  value = join(",", [for image in local.expired : image.image_digest])
+}
+
+output "priorities" {
+  value = join(",", [for image in local.expired : tostring(image.rule_priority)])
+}
+`, policy)
+}
+
+// testECRLifecycleEvaluateFunctionConfig_unixTimestamps uses the same images as testECRLifecycleEvaluateFunctionConfig,
+// with image_pushed_at as Unix epoch seconds, as exported by the aws_ecr_image data source.
+func testECRLifecycleEvaluateFunctionConfig_unixTimestamps(policy string) string {
+	return fmt.Sprintf(`
+locals {
+  images = [
+    {
+      image_digest    = "sha256:v2"
+      image_tags      = ["v2", "latest"]
+      image_pushed_at = 1715299200
+    },
+    {
+      image_digest    = "sha256:v1"
+      image_tags      = ["v1"]
+      image_pushed_at = 1714521600
+    },
+    {
+      image_digest    = "sha256:dev2"
+      image_tags      = ["dev-2"]
+      image_pushed_at = 1715212800
+    },
+    {
+      image_digest    = "sha256:dev1"
+      image_tags      = ["dev-1"]
+      image_pushed_at = 1714867200
+    },
+    {
+      image_digest    = "sha256:untagged1"
+      image_tags      = []
+      image_pushed_at = 1711929600
+    },
+  ]
+
+  expired = provider::aws::ecr_lifecycle_evaluate(%[1]q, local.images, "2024-05-10T12:00:00Z")
+}
+
+output "digests" {
+  value = join(",", [for image in local.expired : image.image_digest])
+}
+
+output "priorities" {
+  value = join(",", [for image in local.expired : tostring(image.rule_priority)])
+}
+`, policy)
+}
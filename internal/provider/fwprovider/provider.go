@@ -471,6 +471,7 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewECRLifecycleEvaluateFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
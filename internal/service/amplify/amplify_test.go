@@ -44,6 +44,10 @@ func TestAccAmplify_serial(t *testing.T) {
 			"EnvironmentVariables": testAccBranch_EnvironmentVariables,
 			"OptionalArguments":    testAccBranch_OptionalArguments,
 		},
+		"Deployment": {
+			acctest.CtBasic: testAccDeployment_basic,
+			"sourceChanged": testAccDeployment_sourceChanged,
+		},
 		"DomainAssociation": {
 			acctest.CtBasic:       testAccDomainAssociation_basic,
 			"certificateSettings": testAccDomainAssociation_certificateSettings,
@@ -0,0 +1,576 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package amplify
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_amplify_deployment", name="Deployment")
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"source_dir", "source_url"},
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 3000),
+				ExactlyOneOf: []string{"source_dir", "source_url"},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: deploymentSourceHashCustomizeDiff,
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AmplifyClient(ctx)
+
+	appID := d.Get("app_id").(string)
+	branchName := d.Get("branch_name").(string)
+	input := &amplify.StartDeploymentInput{
+		AppId:      aws.String(appID),
+		BranchName: aws.String(branchName),
+	}
+
+	if v, ok := d.GetOk("source_dir"); ok {
+		// The hash is computed from the same bytes that are archived and uploaded.
+		archive, hash, err := zipDeploymentSourceDir(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Amplify Deployment (%s/%s): archiving %s: %s", appID, branchName, v.(string), err)
+		}
+		defer os.Remove(archive)
+
+		output, err := conn.CreateDeployment(ctx, &amplify.CreateDeploymentInput{
+			AppId:      aws.String(appID),
+			BranchName: aws.String(branchName),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Amplify Deployment (%s/%s): %s", appID, branchName, err)
+		}
+
+		if err := uploadDeploymentArchive(ctx, aws.ToString(output.ZipUploadUrl), archive); err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading Amplify Deployment (%s/%s) archive: %s", appID, branchName, err)
+		}
+
+		d.Set("source_hash", hash)
+		input.JobId = output.JobId
+	} else {
+		input.SourceUrl = aws.String(d.Get("source_url").(string))
+	}
+
+	output, err := conn.StartDeployment(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Amplify Deployment (%s/%s): %s", appID, branchName, err)
+	}
+
+	jobID := aws.ToString(output.JobSummary.JobId)
+	d.SetId(deploymentCreateResourceID(appID, branchName, jobID))
+
+	if _, err := waitDeploymentSucceeded(ctx, conn, appID, branchName, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "waiting for Amplify Deployment (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AmplifyClient(ctx)
+
+	appID, branchName, jobID, err := deploymentParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	job, err := findJobByThreePartKey(ctx, conn, appID, branchName, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// Keep the recorded result rather than deploying again.
+		log.Printf("[WARN] Amplify Deployment (%s) not found, keeping recorded result", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amplify Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_id", appID)
+	d.Set("branch_name", branchName)
+	d.Set("end_time", flattenDeploymentTime(job.Summary.EndTime))
+	d.Set("job_id", jobID)
+	d.Set("start_time", flattenDeploymentTime(job.Summary.StartTime))
+	d.Set(names.AttrStatus, job.Summary.Status)
+
+	return diags
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AmplifyClient(ctx)
+
+	appID, branchName, jobID, err := deploymentParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	job, err := findJobByThreePartKey(ctx, conn, appID, branchName, jobID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amplify Deployment (%s): %s", d.Id(), err)
+	}
+
+	// Deployed content remains on the branch; only stop a deployment that is still in progress.
+	switch job.Summary.Status {
+	case types.JobStatusPending, types.JobStatusProvisioning, types.JobStatusRunning:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Stopping Amplify Deployment: %s", d.Id())
+	_, err = conn.StopJob(ctx, &amplify.StopJobInput{
+		AppId:      aws.String(appID),
+		BranchName: aws.String(branchName),
+		JobId:      aws.String(jobID),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping Amplify Deployment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// deploymentSourceHashCustomizeDiff plans a new deployment whenever the content of source_dir changes.
+// The planned hash is left unknown, so that the hash recorded on create is always that of the uploaded content,
+// even if source_dir changes between plan and apply.
+func deploymentSourceHashCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") {
+		return d.SetNewComputed("source_hash")
+	}
+
+	v, ok := d.GetOk("source_dir")
+
+	if !ok {
+		return nil
+	}
+
+	// A new or changed source_dir already plans a new deployment.
+	if d.Id() == "" || d.HasChange("source_dir") {
+		return d.SetNewComputed("source_hash")
+	}
+
+	hash, err := hashDeploymentSourceDir(v.(string))
+
+	if err != nil {
+		return fmt.Errorf("reading Amplify Deployment source_dir (%s): %w", v.(string), err)
+	}
+
+	if d.Get("source_hash").(string) == hash {
+		return nil
+	}
+
+	if err := d.SetNewComputed("source_hash"); err != nil {
+		return err
+	}
+
+	return d.ForceNew("source_hash")
+}
+
+type deploymentSourceFile struct {
+	path string // Relative, slash-separated path.
+}
+
+// deploymentSourceFiles returns the files in the specified directory in lexical order of their relative paths.
+// Symbolic links to files are followed. Symbolic links to directories and other non-regular files are rejected.
+func deploymentSourceFiles(dir string) ([]deploymentSourceFile, error) {
+	var files []deploymentSourceFile
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// os.Stat follows symbolic links.
+		info, err := os.Stat(path)
+
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("%s is not a regular file or a symbolic link to a regular file", path)
+		}
+
+		rel, err := filepath.Rel(dir, path)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, deploymentSourceFile{
+			path: filepath.ToSlash(rel),
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files found in %s", dir)
+	}
+
+	slices.SortFunc(files, func(a, b deploymentSourceFile) int {
+		return strings.Compare(a.path, b.path)
+	})
+
+	return files, nil
+}
+
+// hashDeploymentSourceDir returns the SHA-256 hash of the relative paths and content of the files
+// in the specified directory. Permissions aren't hashed, as they depend on the umask of the checkout. File content is streamed, so the directory is never held in memory.
+func hashDeploymentSourceDir(dir string) (string, error) {
+	files, err := deploymentSourceFiles(dir)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+
+	for _, file := range files {
+		if err := copyDeploymentSourceFile(io.Discard, hash, dir, file); err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// zipDeploymentSourceDir writes a deterministic zip archive of the specified directory to a temporary file
+// and returns its path together with the hash of the archived content, as computed by hashDeploymentSourceDir.
+// Files are added in lexical order with fixed timestamps and permissions so that identical content produces an identical archive.
+// The caller must remove the file.
+func zipDeploymentSourceDir(dir string) (string, string, error) {
+	files, err := deploymentSourceFiles(dir)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	f, err := os.CreateTemp("", "terraform-provider-aws-amplify-deployment-*.zip")
+
+	if err != nil {
+		return "", "", err
+	}
+
+	hash, err := writeDeploymentArchive(f, dir, files)
+
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+
+		return "", "", err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+
+		return "", "", err
+	}
+
+	return f.Name(), hash, nil
+}
+
+func writeDeploymentArchive(dst io.Writer, dir string, files []deploymentSourceFile) (string, error) {
+	w := zip.NewWriter(dst)
+	hash := sha256.New()
+	modified := time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, file := range files {
+		header := &zip.FileHeader{
+			Name:     file.path,
+			Method:   zip.Deflate,
+			Modified: modified,
+		}
+		header.SetMode(0o644)
+
+		f, err := w.CreateHeader(header)
+
+		if err != nil {
+			return "", err
+		}
+
+		if err := copyDeploymentSourceFile(f, hash, dir, file); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// copyDeploymentSourceFile copies a file's content to dst and adds its relative path and content to hash.
+func copyDeploymentSourceFile(dst, hash io.Writer, dir string, file deploymentSourceFile) error {
+	f, err := os.Open(filepath.Join(dir, filepath.FromSlash(file.path)))
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	// Length-prefix the path and terminate the content with its length so that distinct files can never produce the same input.
+	fmt.Fprintf(hash, "%d:%s\x00", len(file.path), file.path)
+
+	n, err := io.Copy(io.MultiWriter(dst, hash), f)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(hash, "\x00%d\x00", n)
+
+	return nil
+}
+
+func uploadDeploymentArchive(ctx context.Context, url, path string) error {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, url, f)
+
+	if err != nil {
+		return err
+	}
+
+	request.ContentLength = info.Size()
+	request.Header.Set("Content-Type", "application/zip")
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+
+		return fmt.Errorf("HTTP %d: %s", response.StatusCode, body)
+	}
+
+	return nil
+}
+
+func findJobByThreePartKey(ctx context.Context, conn *amplify.Client, appID, branchName, jobID string) (*types.Job, error) {
+	input := &amplify.GetJobInput{
+		AppId:      aws.String(appID),
+		BranchName: aws.String(branchName),
+		JobId:      aws.String(jobID),
+	}
+
+	output, err := conn.GetJob(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil || output.Job.Summary == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func statusDeployment(ctx context.Context, conn *amplify.Client, appID, branchName, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByThreePartKey(ctx, conn, appID, branchName, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Summary.Status), nil
+	}
+}
+
+func waitDeploymentSucceeded(ctx context.Context, conn *amplify.Client, appID, branchName, jobID string, timeout time.Duration) (*types.Job, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.JobStatusPending, types.JobStatusProvisioning, types.JobStatusRunning),
+		Target:  enum.Slice(types.JobStatusSucceed),
+		Refresh: statusDeployment(ctx, conn, appID, branchName, jobID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Job); ok {
+		for _, step := range output.Steps {
+			if step.Status == types.JobStatusFailed {
+				tfresource.SetLastError(err, fmt.Errorf("step %s: %s", aws.ToString(step.StepName), aws.ToString(step.StatusReason)))
+				break
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenDeploymentTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.ToTime(t).Format(time.RFC3339)
+}
+
+const deploymentResourceIDSeparator = "/"
+
+func deploymentCreateResourceID(appID, branchName, jobID string) string {
+	parts := []string{appID, branchName, jobID}
+	id := strings.Join(parts, deploymentResourceIDSeparator)
+
+	return id
+}
+
+// deploymentParseResourceID parses an ID of the form APPID/BRANCHNAME/JOBID.
+// Branch names may themselves contain the separator.
+func deploymentParseResourceID(id string) (string, string, string, error) {
+	first := strings.Index(id, deploymentResourceIDSeparator)
+	last := strings.LastIndex(id, deploymentResourceIDSeparator)
+
+	if first > 0 && last > first+1 && last < len(id)-1 {
+		return id[:first], id[first+1 : last], id[last+1:], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPID%[2]sBRANCHNAME%[2]sJOBID", id, deploymentResourceIDSeparator)
+}
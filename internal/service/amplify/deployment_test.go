@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package amplify_test
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfamplify "github.com/hashicorp/terraform-provider-aws/internal/service/amplify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDeploymentParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName           string
+		InputID            string
+		ExpectedAppID      string
+		ExpectedBranchName string
+		ExpectedJobID      string
+		ExpectError        bool
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "two parts",
+			InputID:     "d2ypk4k47z8u6/main",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty branch name",
+			InputID:     "d2ypk4k47z8u6//1",
+			ExpectError: true,
+		},
+		{
+			TestName:           "valid ID",
+			InputID:            "d2ypk4k47z8u6/main/1",
+			ExpectedAppID:      "d2ypk4k47z8u6",
+			ExpectedBranchName: "main",
+			ExpectedJobID:      "1",
+		},
+		{
+			TestName:           "branch name with separator",
+			InputID:            "d2ypk4k47z8u6/feature/login/12",
+			ExpectedAppID:      "d2ypk4k47z8u6",
+			ExpectedBranchName: "feature/login",
+			ExpectedJobID:      "12",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			appID, branchName, jobID, err := tfamplify.DeploymentParseResourceID(testCase.InputID)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if appID != testCase.ExpectedAppID || branchName != testCase.ExpectedBranchName || jobID != testCase.ExpectedJobID {
+				t.Errorf("got (%s, %s, %s), expected (%s, %s, %s)", appID, branchName, jobID, testCase.ExpectedAppID, testCase.ExpectedBranchName, testCase.ExpectedJobID)
+			}
+		})
+	}
+}
+
+func TestHashDeploymentSourceDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeDeploymentSource(t, dir, "index.html", "<h1>hello</h1>")
+	writeDeploymentSource(t, dir, "assets/app.js", "console.log('hello');")
+
+	hash1, err := tfamplify.HashDeploymentSourceDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Rewriting identical content with new modification times must not change the hash.
+	writeDeploymentSource(t, dir, "index.html", "<h1>hello</h1>")
+
+	hash2, err := tfamplify.HashDeploymentSourceDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("hashes of identical content differ: %s, %s", hash1, hash2)
+	}
+
+	writeDeploymentSource(t, dir, "index.html", "<h1>goodbye</h1>")
+
+	hash3, err := tfamplify.HashDeploymentSourceDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 == hash3 {
+		t.Errorf("hashes of different content are the same: %s", hash1)
+	}
+
+	if err := os.Chmod(filepath.Join(dir, "index.html"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	hash4, err := tfamplify.HashDeploymentSourceDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Permissions depend on the umask of the checkout, so they must not change the hash.
+	if hash3 != hash4 {
+		t.Errorf("hashes of identical content with different file modes differ: %s, %s", hash3, hash4)
+	}
+
+	// Symbolic links to files are followed.
+	target := filepath.Join(t.TempDir(), "robots.txt")
+	if err := os.WriteFile(target, []byte("User-agent: *"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(target, filepath.Join(dir, "robots.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	hash5, err := tfamplify.HashDeploymentSourceDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash4 == hash5 {
+		t.Errorf("symbolic link to a file was not hashed: %s", hash4)
+	}
+
+	// Symbolic links to directories are rejected.
+	if err := os.Symlink(t.TempDir(), filepath.Join(dir, "linked")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tfamplify.HashDeploymentSourceDir(dir); err == nil {
+		t.Error("expected error for symbolic link to a directory")
+	}
+
+	if _, err := tfamplify.HashDeploymentSourceDir(t.TempDir()); err == nil {
+		t.Error("expected error for empty directory")
+	}
+}
+
+func TestZipDeploymentSourceDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeDeploymentSource(t, dir, "index.html", "<h1>hello</h1>")
+	writeDeploymentSource(t, dir, "assets/app.js", "console.log('hello');")
+
+	archive, hash, err := tfamplify.ZipDeploymentSourceDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer os.Remove(archive)
+
+	// The archived content must have the same hash as planned.
+	want, err := tfamplify.HashDeploymentSourceDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash != want {
+		t.Errorf("archive hash = %s, want %s", hash, want)
+	}
+
+	r, err := zip.OpenReader(archive)
+	if err != nil {
+		t.Fatalf("opening archive: %s", err)
+	}
+	defer r.Close()
+
+	var got []string
+	for _, f := range r.File {
+		got = append(got, f.Name)
+	}
+
+	if want := []string{"assets/app.js", "index.html"}; !slices.Equal(got, want) {
+		t.Errorf("archive files = %v, want %v", got, want)
+	}
+}
+
+func testAccDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job types.Job
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_deployment.test"
+	sourceDir := t.TempDir()
+	writeDeploymentSource(t, sourceDir, "index.html", "<h1>hello</h1>")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBranchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", "aws_amplify_app.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_hash"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusSucceed)),
+				),
+			},
+			{
+				Config:   testAccDeploymentConfig_sourceDir(rName, sourceDir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccDeployment_sourceChanged(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job1, job2 types.Job
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_deployment.test"
+	sourceDir := t.TempDir()
+	writeDeploymentSource(t, sourceDir, "index.html", "<h1>hello</h1>")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBranchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &job1),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeDeploymentSource(t, sourceDir, "index.html", "<h1>goodbye</h1>")
+				},
+				Config: testAccDeploymentConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &job2),
+					testAccCheckDeploymentRecreated(&job1, &job2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *types.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AmplifyClient(ctx)
+
+		output, err := tfamplify.FindJobByThreePartKey(ctx, conn, rs.Primary.Attributes["app_id"], rs.Primary.Attributes["branch_name"], rs.Primary.Attributes["job_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentRecreated(before, after *types.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := before.Summary.JobId, after.Summary.JobId; before != nil && after != nil && *before == *after {
+			return fmt.Errorf("Amplify Deployment (%s) not recreated", *before)
+		}
+
+		return nil
+	}
+}
+
+func writeDeploymentSource(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDeploymentConfig_sourceDir(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[1]q
+}
+
+resource "aws_amplify_deployment" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = aws_amplify_branch.test.branch_name
+  source_dir  = %[2]q
+}
+`, rName, sourceDir)
+}
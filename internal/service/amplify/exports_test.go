@@ -8,6 +8,7 @@ var (
 	ResourceApp                = resourceApp
 	ResourceBackendEnvironment = resourceBackendEnvironment
 	ResourceBranch             = resourceBranch
+	ResourceDeployment         = resourceDeployment
 	ResourceDomainAssociation  = resourceDomainAssociation
 	ResourceWebhook            = resourceWebhook
 
@@ -15,5 +16,10 @@ var (
 	FindBackendEnvironmentByTwoPartKey = findBackendEnvironmentByTwoPartKey
 	FindBranchByTwoPartKey             = findBranchByTwoPartKey
 	FindDomainAssociationByTwoPartKey  = findDomainAssociationByTwoPartKey
+	FindJobByThreePartKey              = findJobByThreePartKey
 	FindWebhookByID                    = findWebhookByID
+
+	DeploymentParseResourceID = deploymentParseResourceID
+	HashDeploymentSourceDir   = hashDeploymentSourceDir
+	ZipDeploymentSourceDir    = zipDeploymentSourceDir
 )
@@ -49,6 +49,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceDeployment,
+			TypeName: "aws_amplify_deployment",
+			Name:     "Deployment",
+		},
 		{
 			Factory:  resourceDomainAssociation,
 			TypeName: "aws_amplify_domain_association",
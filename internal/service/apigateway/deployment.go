@@ -37,6 +37,8 @@ func resourceDeployment() *schema.Resource {
 			StateContext: resourceDeploymentImport,
 		},
 
+		CustomizeDiff: resourceDeploymentRedeployOnChangeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
@@ -73,10 +75,18 @@ func resourceDeployment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"invoke_url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"redeploy_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"rest_api_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -120,6 +130,18 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		Variables:        flex.ExpandStringValueMap(d.Get("variables").(map[string]interface{})),
 	}
 
+	var fingerprint string
+	if d.Get("redeploy_on_change").(bool) {
+		restAPIID := d.Get("rest_api_id").(string)
+		v, err := restAPIFingerprint(ctx, conn, restAPIID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s) fingerprint: %s", restAPIID, err)
+		}
+
+		fingerprint = v
+	}
+
 	deployment, err := conn.CreateDeployment(ctx, input)
 
 	if err != nil {
@@ -127,6 +149,7 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(aws.ToString(deployment.Id))
+	d.Set("fingerprint", fingerprint)
 	if v, ok := d.GetOk("canary_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.CanarySettings = expandDeploymentCanarySettings(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -247,6 +270,57 @@ func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// resourceDeploymentRedeployOnChangeDiff compares the fingerprint recorded when the deployment was created
+// with the fingerprint of the REST API's current resources, methods and integrations, and replaces the
+// deployment if they differ.
+func resourceDeploymentRedeployOnChangeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	old := d.Get("fingerprint").(string)
+
+	if !d.Get("redeploy_on_change").(bool) {
+		if old != "" {
+			return d.SetNew("fingerprint", "")
+		}
+
+		return nil
+	}
+
+	if !d.NewValueKnown("rest_api_id") || d.HasChange("rest_api_id") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	restAPIID := d.Get("rest_api_id").(string)
+	fingerprint, err := restAPIFingerprint(ctx, conn, restAPIID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading API Gateway REST API (%s) fingerprint: %w", restAPIID, err)
+	}
+
+	if fingerprint == old {
+		return nil
+	}
+
+	if err := d.SetNew("fingerprint", fingerprint); err != nil {
+		return err
+	}
+
+	// Enabling redeploy_on_change on an existing deployment only records the current fingerprint.
+	if old == "" {
+		return nil
+	}
+
+	return d.ForceNew("fingerprint")
+}
+
 func resourceDeploymentImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.Split(d.Id(), "/")
 	if len(idParts) != 2 {
@@ -11,8 +11,10 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -169,6 +171,61 @@ func TestAccAPIGatewayDeployment_triggers(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayDeployment_redeployOnChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment1, deployment2, deployment3 apigateway.GetDeploymentOutput
+	var restAPI apigateway.GetRestApiOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_redeployOnChange(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment1),
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+					resource.TestCheckResourceAttr(resourceName, "redeploy_on_change", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_redeployOnChange(rName, "https://example.com"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment2),
+					testAccCheckDeploymentNotRecreated(&deployment1, &deployment2),
+					testAccCheckRESTAPIExists(ctx, "aws_api_gateway_rest_api.test", &restAPI),
+				),
+			},
+			{
+				// Change the live REST API to match the new configuration so that only the deployment differs.
+				PreConfig: func() {
+					testAccUpdateDeploymentIntegrationURI(ctx, t, &restAPI, "https://example.org")
+				},
+				Config: testAccDeploymentConfig_redeployOnChange(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction("aws_api_gateway_integration.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment3),
+					testAccCheckDeploymentRecreated(&deployment2, &deployment3),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayDeployment_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var deployment apigateway.GetDeploymentOutput
@@ -425,6 +482,42 @@ func testAccCheckDeploymentRecreated(i, j *apigateway.GetDeploymentOutput) resou
 	}
 }
 
+// testAccUpdateDeploymentIntegrationURI changes the URI of the GET /test integration outside of Terraform.
+func testAccUpdateDeploymentIntegrationURI(ctx context.Context, t *testing.T, restAPI *apigateway.GetRestApiOutput, uri string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+	resources, err := conn.GetResources(ctx, &apigateway.GetResourcesInput{
+		RestApiId: restAPI.Id,
+	})
+
+	if err != nil {
+		t.Fatalf("reading API Gateway REST API (%s) resources: %s", aws.ToString(restAPI.Id), err)
+	}
+
+	for _, v := range resources.Items {
+		if aws.ToString(v.Path) != "/test" {
+			continue
+		}
+
+		_, err := conn.UpdateIntegration(ctx, &apigateway.UpdateIntegrationInput{
+			HttpMethod: aws.String("GET"),
+			PatchOperations: []types.PatchOperation{{
+				Op:    types.OpReplace,
+				Path:  aws.String("/uri"),
+				Value: aws.String(uri),
+			}},
+			ResourceId: v.Id,
+			RestApiId:  restAPI.Id,
+		})
+
+		if err != nil {
+			t.Fatalf("updating API Gateway REST API (%s) integration: %s", aws.ToString(restAPI.Id), err)
+		}
+	}
+}
+
 func testAccDeploymentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -500,6 +593,21 @@ resource "aws_api_gateway_deployment" "test" {
 `, description))
 }
 
+func testAccDeploymentConfig_redeployOnChange(rName, uri string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, uri), `
+resource "aws_api_gateway_deployment" "test" {
+  depends_on = [aws_api_gateway_integration_response.test]
+
+  rest_api_id        = aws_api_gateway_rest_api.test.id
+  redeploy_on_change = true
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`)
+}
+
 func testAccDeploymentConfig_description(rName, description string) string {
 	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, "http://example.com"), fmt.Sprintf(`
 resource "aws_api_gateway_deployment" "test" {
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_api_gateway_resources", name="Resources")
+func dataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"methods": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_key_required": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"authorization": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"authorizer_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"http_method": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"integration_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"integration_uri": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"operation_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"parent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPath: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path_part": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rest_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	apiID := d.Get("rest_api_id").(string)
+	input := &apigateway.GetResourcesInput{
+		Embed:     []string{"methods"},
+		RestApiId: aws.String(apiID),
+	}
+
+	filter := tfslices.PredicateTrue[*types.Resource]()
+	if v, ok := d.GetOk("path_prefix"); ok {
+		pathPrefix := v.(string)
+		filter = func(v *types.Resource) bool {
+			return strings.HasPrefix(aws.ToString(v.Path), pathPrefix)
+		}
+	}
+
+	resources, err := findResources(ctx, conn, input, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s) resources: %s", apiID, err)
+	}
+
+	slices.SortFunc(resources, func(a, b types.Resource) int {
+		return strings.Compare(aws.ToString(a.Path), aws.ToString(b.Path))
+	})
+
+	d.SetId(apiID)
+	if err := d.Set("items", flattenResources(resources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting items: %s", err)
+	}
+
+	return diags
+}
+
+func flattenResources(apiObjects []types.Resource) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID:   aws.ToString(apiObject.Id),
+			"methods":      flattenResourceMethods(apiObject.ResourceMethods),
+			"parent_id":    aws.ToString(apiObject.ParentId),
+			names.AttrPath: aws.ToString(apiObject.Path),
+			"path_part":    aws.ToString(apiObject.PathPart),
+		})
+	}
+
+	return tfList
+}
+
+func flattenResourceMethods(apiObjects map[string]types.Method) []interface{} {
+	httpMethods := tfmaps.Keys(apiObjects)
+	slices.Sort(httpMethods)
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, httpMethod := range httpMethods {
+		apiObject := apiObjects[httpMethod]
+		tfMap := map[string]interface{}{
+			"api_key_required": aws.ToBool(apiObject.ApiKeyRequired),
+			"authorization":    aws.ToString(apiObject.AuthorizationType),
+			"authorizer_id":    aws.ToString(apiObject.AuthorizerId),
+			"http_method":      httpMethod,
+			"operation_name":   aws.ToString(apiObject.OperationName),
+		}
+
+		if v := apiObject.MethodIntegration; v != nil {
+			tfMap["integration_type"] = string(v.Type)
+			tfMap["integration_uri"] = aws.ToString(v.Uri)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSource1Name := "data.aws_api_gateway_resources.all"
+	dataSource2Name := "data.aws_api_gateway_resources.prefix"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "items.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSource1Name, "items.0.path", "/"),
+					resource.TestCheckResourceAttr(dataSource1Name, "items.1.path", "/v1"),
+					resource.TestCheckResourceAttr(dataSource1Name, "items.2.path", "/v1/endpoint"),
+					resource.TestCheckResourceAttr(dataSource1Name, "items.2.methods.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSource1Name, "items.2.methods.0.http_method", "GET"),
+					resource.TestCheckResourceAttr(dataSource1Name, "items.2.methods.0.authorization", "NONE"),
+					resource.TestCheckResourceAttr(dataSource1Name, "items.2.methods.0.integration_type", "MOCK"),
+					resource.TestCheckResourceAttr(dataSource2Name, "items.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "items.0.id", "aws_api_gateway_resource.v1", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "items.1.id", "aws_api_gateway_resource.endpoint", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "items.1.parent_id", "aws_api_gateway_resource.v1", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+
+resource "aws_api_gateway_resource" "v1" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  parent_id   = aws_api_gateway_rest_api.test.root_resource_id
+  path_part   = "v1"
+}
+
+resource "aws_api_gateway_resource" "endpoint" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  parent_id   = aws_api_gateway_resource.v1.id
+  path_part   = "endpoint"
+}
+
+resource "aws_api_gateway_method" "test" {
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  resource_id   = aws_api_gateway_resource.endpoint.id
+  http_method   = "GET"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_integration" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  resource_id = aws_api_gateway_resource.endpoint.id
+  http_method = aws_api_gateway_method.test.http_method
+  type        = "MOCK"
+}
+
+data "aws_api_gateway_resources" "all" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+
+  depends_on = [aws_api_gateway_integration.test]
+}
+
+data "aws_api_gateway_resources" "prefix" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  path_prefix = "/v1"
+
+  depends_on = [aws_api_gateway_integration.test]
+}
+`, rName)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// @SDKDataSource("aws_api_gateway_rest_api_fingerprint", name="REST API Fingerprint")
+func dataSourceRestAPIFingerprint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRestAPIFingerprintRead,
+
+		Schema: map[string]*schema.Schema{
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rest_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceRestAPIFingerprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	restAPIID := d.Get("rest_api_id").(string)
+	fingerprint, err := restAPIFingerprint(ctx, conn, restAPIID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s) fingerprint: %s", restAPIID, err)
+	}
+
+	d.SetId(restAPIID)
+	d.Set("fingerprint", fingerprint)
+
+	return diags
+}
+
+// restAPIFingerprint returns a digest of the REST API's resources together with their embedded methods,
+// method responses, integrations and integration responses.
+func restAPIFingerprint(ctx context.Context, conn *apigateway.Client, restAPIID string) (string, error) {
+	input := &apigateway.GetResourcesInput{
+		Embed:     []string{"methods"},
+		RestApiId: aws.String(restAPIID),
+	}
+
+	resources, err := findResources(ctx, conn, input, tfslices.PredicateTrue[*types.Resource]())
+
+	if err != nil {
+		return "", err
+	}
+
+	slices.SortFunc(resources, func(a, b types.Resource) int {
+		return strings.Compare(aws.ToString(a.Path), aws.ToString(b.Path))
+	})
+
+	// encoding/json sorts map keys, so identical trees always produce identical documents.
+	b, err := json.Marshal(resources)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayRestAPIFingerprintDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_api_gateway_rest_api_fingerprint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIFingerprintDataSourceConfig_basic(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "fingerprint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rest_api_id", "aws_api_gateway_rest_api.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayRestAPIFingerprintDataSource_deploymentTriggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment1, deployment2 apigateway.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIFingerprintDataSourceConfig_deploymentTriggers(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment1),
+					resource.TestCheckResourceAttrSet(resourceName, "triggers.redeployment"),
+				),
+			},
+			{
+				Config: testAccRestAPIFingerprintDataSourceConfig_deploymentTriggers(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment2),
+					testAccCheckDeploymentRecreated(&deployment1, &deployment2),
+				),
+			},
+		},
+	})
+}
+
+func testAccRestAPIFingerprintDataSourceConfig_basic(rName, uri string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, uri), `
+data "aws_api_gateway_rest_api_fingerprint" "test" {
+  depends_on = [aws_api_gateway_integration_response.test]
+
+  rest_api_id = aws_api_gateway_rest_api.test.id
+}
+`)
+}
+
+func testAccRestAPIFingerprintDataSourceConfig_deploymentTriggers(rName, uri string) string {
+	return acctest.ConfigCompose(testAccRestAPIFingerprintDataSourceConfig_basic(rName, uri), `
+resource "aws_api_gateway_deployment" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+
+  triggers = {
+    redeployment = data.aws_api_gateway_rest_api_fingerprint.test.fingerprint
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_api_gateway_rest_apis", name="REST APIs")
+func dataSourceRestAPIs() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRestAPIsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrEndpointType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.EndpointType](),
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceRestAPIsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	var endpointType types.EndpointType
+	if v, ok := d.GetOk(names.AttrEndpointType); ok {
+		endpointType = types.EndpointType(v.(string))
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexache.MustCompile(v.(string))
+	}
+
+	filter := func(v *types.RestApi) bool {
+		if nameRegex != nil && !nameRegex.MatchString(aws.ToString(v.Name)) {
+			return false
+		}
+
+		if endpointType != "" && (v.EndpointConfiguration == nil || !slices.Contains(v.EndpointConfiguration.Types, endpointType)) {
+			return false
+		}
+
+		if len(tagsToMatch) > 0 && !KeyValueTags(ctx, v.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+			return false
+		}
+
+		return true
+	}
+
+	restAPIs, err := findRestAPIs(ctx, conn, &apigateway.GetRestApisInput{}, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway REST APIs: %s", err)
+	}
+
+	var ids, apiNames []string
+
+	for _, v := range restAPIs {
+		ids = append(ids, aws.ToString(v.Id))
+		apiNames = append(apiNames, aws.ToString(v.Name))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrIDs, ids)
+	d.Set(names.AttrNames, apiNames)
+
+	return diags
+}
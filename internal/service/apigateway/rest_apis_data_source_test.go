@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayRestAPIsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSource1Name := "data.aws_api_gateway_rest_apis.by_name"
+	dataSource2Name := "data.aws_api_gateway_rest_apis.by_endpoint_type"
+	dataSource3Name := "data.aws_api_gateway_rest_apis.by_tags"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSource1Name, "names.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSource2Name, "ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(dataSource2Name, "ids.*", "aws_api_gateway_rest_api.test2", names.AttrID),
+					resource.TestCheckResourceAttr(dataSource3Name, "ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(dataSource3Name, "ids.*", "aws_api_gateway_rest_api.test1", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccRestAPIsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test1" {
+  name = "%[1]s-1"
+
+  tags = {
+    Name = "%[1]s-1"
+  }
+}
+
+resource "aws_api_gateway_rest_api" "test2" {
+  name = "%[1]s-2"
+
+  endpoint_configuration {
+    types = ["REGIONAL"]
+  }
+}
+
+data "aws_api_gateway_rest_apis" "by_name" {
+  name_regex = "^%[1]s-"
+
+  depends_on = [aws_api_gateway_rest_api.test1, aws_api_gateway_rest_api.test2]
+}
+
+data "aws_api_gateway_rest_apis" "by_endpoint_type" {
+  name_regex    = "^%[1]s-"
+  endpoint_type = "REGIONAL"
+
+  depends_on = [aws_api_gateway_rest_api.test1, aws_api_gateway_rest_api.test2]
+}
+
+data "aws_api_gateway_rest_apis" "by_tags" {
+  tags = {
+    Name = "%[1]s-1"
+  }
+
+  depends_on = [aws_api_gateway_rest_api.test1, aws_api_gateway_rest_api.test2]
+}
+`, rName)
+}
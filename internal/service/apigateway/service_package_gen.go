@@ -54,12 +54,27 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_api_gateway_resource",
 			Name:     "Resource",
 		},
+		{
+			Factory:  dataSourceResources,
+			TypeName: "aws_api_gateway_resources",
+			Name:     "Resources",
+		},
 		{
 			Factory:  dataSourceRestAPI,
 			TypeName: "aws_api_gateway_rest_api",
 			Name:     "REST API",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceRestAPIFingerprint,
+			TypeName: "aws_api_gateway_rest_api_fingerprint",
+			Name:     "REST API Fingerprint",
+		},
+		{
+			Factory:  dataSourceRestAPIs,
+			TypeName: "aws_api_gateway_rest_apis",
+			Name:     "REST APIs",
+		},
 		{
 			Factory:  dataSourceSDK,
 			TypeName: "aws_api_gateway_sdk",
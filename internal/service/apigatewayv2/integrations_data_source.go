@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_apigatewayv2_integrations", name="Integrations")
+func dataSourceIntegrations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceIntegrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"integration_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.IntegrationType](),
+			},
+			"integrations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_subtype": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"payload_format_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timeout_milliseconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIntegrationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Client(ctx)
+
+	apiID := d.Get("api_id").(string)
+	integrations, err := findIntegrations(ctx, conn, &apigatewayv2.GetIntegrationsInput{
+		ApiId: aws.String(apiID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 API (%s) integrations: %s", apiID, err)
+	}
+
+	var ids []string
+	var tfList []interface{}
+
+	for _, integration := range integrations {
+		if v, ok := d.GetOk("integration_type"); ok && v.(string) != string(integration.IntegrationType) {
+			continue
+		}
+
+		ids = append(ids, aws.ToString(integration.IntegrationId))
+		tfList = append(tfList, map[string]interface{}{
+			"connection_id":          aws.ToString(integration.ConnectionId),
+			"connection_type":        string(integration.ConnectionType),
+			names.AttrDescription:    aws.ToString(integration.Description),
+			names.AttrID:             aws.ToString(integration.IntegrationId),
+			"integration_method":     aws.ToString(integration.IntegrationMethod),
+			"integration_subtype":    aws.ToString(integration.IntegrationSubtype),
+			"integration_type":       string(integration.IntegrationType),
+			"integration_uri":        aws.ToString(integration.IntegrationUri),
+			"payload_format_version": aws.ToString(integration.PayloadFormatVersion),
+			"timeout_milliseconds":   aws.ToInt32(integration.TimeoutInMillis),
+		})
+	}
+
+	d.SetId(apiID)
+	d.Set(names.AttrIDs, ids)
+	if err := d.Set("integrations", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting integrations: %s", err)
+	}
+
+	return diags
+}
+
+func findIntegrations(ctx context.Context, conn *apigatewayv2.Client, input *apigatewayv2.GetIntegrationsInput) ([]awstypes.Integration, error) {
+	var integrations []awstypes.Integration
+
+	err := getIntegrationsPages(ctx, conn, input, func(page *apigatewayv2.GetIntegrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		integrations = append(integrations, page.Items...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return integrations, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayV2IntegrationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSource1Name := "data.aws_apigatewayv2_integrations.all"
+	dataSource2Name := "data.aws_apigatewayv2_integrations.filtered"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSource1Name, "integrations.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSource2Name, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "integrations.0.id", "aws_apigatewayv2_integration.mock", names.AttrID),
+					resource.TestCheckResourceAttr(dataSource2Name, "integrations.0.integration_type", "MOCK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name                       = %[1]q
+  protocol_type              = "WEBSOCKET"
+  route_selection_expression = "$request.body.action"
+}
+
+resource "aws_apigatewayv2_integration" "mock" {
+  api_id           = aws_apigatewayv2_api.test.id
+  integration_type = "MOCK"
+}
+
+resource "aws_apigatewayv2_integration" "http" {
+  api_id             = aws_apigatewayv2_api.test.id
+  integration_type   = "HTTP"
+  integration_method = "GET"
+  integration_uri    = "https://example.com"
+}
+
+data "aws_apigatewayv2_integrations" "all" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  depends_on = [aws_apigatewayv2_integration.mock, aws_apigatewayv2_integration.http]
+}
+
+data "aws_apigatewayv2_integrations" "filtered" {
+  api_id           = aws_apigatewayv2_api.test.id
+  integration_type = "MOCK"
+
+  depends_on = [aws_apigatewayv2_integration.mock, aws_apigatewayv2_integration.http]
+}
+`, rName)
+}
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=GetApis,GetApiMappings,GetDomainNames,GetVpcLinks -AWSSDKVersion=2"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=GetApis,GetApiMappings,GetDomainNames,GetIntegrations,GetRoutes,GetVpcLinks -AWSSDKVersion=2"; DO NOT EDIT.
 
 package apigatewayv2
 
@@ -57,6 +57,38 @@ func getDomainNamesPages(ctx context.Context, conn *apigatewayv2.Client, input *
 	}
 	return nil
 }
+func getIntegrationsPages(ctx context.Context, conn *apigatewayv2.Client, input *apigatewayv2.GetIntegrationsInput, fn func(*apigatewayv2.GetIntegrationsOutput, bool) bool) error {
+	for {
+		output, err := conn.GetIntegrations(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func getRoutesPages(ctx context.Context, conn *apigatewayv2.Client, input *apigatewayv2.GetRoutesInput, fn func(*apigatewayv2.GetRoutesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetRoutes(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func getVPCLinksPages(ctx context.Context, conn *apigatewayv2.Client, input *apigatewayv2.GetVpcLinksInput, fn func(*apigatewayv2.GetVpcLinksOutput, bool) bool) error {
 	for {
 		output, err := conn.GetVpcLinks(ctx, input)
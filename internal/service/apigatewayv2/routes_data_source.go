@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_apigatewayv2_routes", name="Routes")
+func dataSourceRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authorization_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AuthorizationType](),
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"route_key_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"authorization_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorizer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operation_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTarget: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Client(ctx)
+
+	apiID := d.Get("api_id").(string)
+	routes, err := findRoutes(ctx, conn, &apigatewayv2.GetRoutesInput{
+		ApiId: aws.String(apiID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 API (%s) routes: %s", apiID, err)
+	}
+
+	var routeKeyRegex *regexp.Regexp
+	if v, ok := d.GetOk("route_key_regex"); ok {
+		routeKeyRegex = regexache.MustCompile(v.(string))
+	}
+
+	var ids []string
+	var tfList []interface{}
+
+	for _, route := range routes {
+		if v, ok := d.GetOk("authorization_type"); ok && v.(string) != string(route.AuthorizationType) {
+			continue
+		}
+
+		if routeKeyRegex != nil && !routeKeyRegex.MatchString(aws.ToString(route.RouteKey)) {
+			continue
+		}
+
+		ids = append(ids, aws.ToString(route.RouteId))
+		tfList = append(tfList, map[string]interface{}{
+			"api_key_required":   aws.ToBool(route.ApiKeyRequired),
+			"authorization_type": string(route.AuthorizationType),
+			"authorizer_id":      aws.ToString(route.AuthorizerId),
+			names.AttrID:         aws.ToString(route.RouteId),
+			"operation_name":     aws.ToString(route.OperationName),
+			"route_key":          aws.ToString(route.RouteKey),
+			names.AttrTarget:     aws.ToString(route.Target),
+		})
+	}
+
+	d.SetId(apiID)
+	d.Set(names.AttrIDs, ids)
+	if err := d.Set("routes", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting routes: %s", err)
+	}
+
+	return diags
+}
+
+func findRoutes(ctx context.Context, conn *apigatewayv2.Client, input *apigatewayv2.GetRoutesInput) ([]awstypes.Route, error) {
+	var routes []awstypes.Route
+
+	err := getRoutesPages(ctx, conn, input, func(page *apigatewayv2.GetRoutesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		routes = append(routes, page.Items...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
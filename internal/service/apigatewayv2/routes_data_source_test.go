@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayV2RoutesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSource1Name := "data.aws_apigatewayv2_routes.all"
+	dataSource2Name := "data.aws_apigatewayv2_routes.filtered"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSource1Name, "routes.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSource2Name, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "routes.0.id", "aws_apigatewayv2_route.test1", names.AttrID),
+					resource.TestCheckResourceAttr(dataSource2Name, "routes.0.route_key", "GET /pets"),
+					resource.TestCheckResourceAttr(dataSource2Name, "routes.0.authorization_type", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+}
+
+resource "aws_apigatewayv2_route" "test1" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /pets"
+}
+
+resource "aws_apigatewayv2_route" "test2" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "POST /orders"
+}
+
+data "aws_apigatewayv2_routes" "all" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  depends_on = [aws_apigatewayv2_route.test1, aws_apigatewayv2_route.test2]
+}
+
+data "aws_apigatewayv2_routes" "filtered" {
+  api_id          = aws_apigatewayv2_api.test.id
+  route_key_regex = "/pets$"
+
+  depends_on = [aws_apigatewayv2_route.test1, aws_apigatewayv2_route.test2]
+}
+`, rName)
+}
@@ -38,6 +38,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_apigatewayv2_export",
 			Name:     "Export",
 		},
+		{
+			Factory:  dataSourceIntegrations,
+			TypeName: "aws_apigatewayv2_integrations",
+			Name:     "Integrations",
+		},
+		{
+			Factory:  dataSourceRoutes,
+			TypeName: "aws_apigatewayv2_routes",
+			Name:     "Routes",
+		},
 		{
 			Factory:  dataSourceVPCLink,
 			TypeName: "aws_apigatewayv2_vpc_link",
@@ -5,6 +5,7 @@ package appconfig
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -17,9 +18,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appconfig"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -38,8 +41,18 @@ func ResourceDeployment() *schema.Resource {
 		ReadWithoutTimeout:   resourceDeploymentRead,
 		UpdateWithoutTimeout: resourceDeploymentUpdate,
 		DeleteWithoutTimeout: resourceDeploymentDelete,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("stop_deployment_on_timeout", false)
+				d.Set("wait_for_deployment", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,8 +116,18 @@ func ResourceDeployment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"stop_deployment_on_timeout": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 		CustomizeDiff: verify.SetTagsDiff,
 	}
@@ -145,6 +168,25 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%s/%s/%d", appID, envID, output.DeploymentNumber))
 
+	if d.Get("wait_for_deployment").(bool) {
+		if _, err := waitDeploymentCompleted(ctx, conn, appID, envID, output.DeploymentNumber, d.Timeout(schema.TimeoutCreate)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "waiting for AppConfig Deployment (%s) complete: %s", d.Id(), err)
+
+			if tfresource.TimedOut(err) && d.Get("stop_deployment_on_timeout").(bool) {
+				log.Printf("[DEBUG] Stopping AppConfig Deployment: %s", d.Id())
+				_, err := conn.StopDeployment(ctx, &appconfig.StopDeploymentInput{
+					ApplicationId:    aws.String(appID),
+					DeploymentNumber: aws.Int32(output.DeploymentNumber),
+					EnvironmentId:    aws.String(envID),
+				})
+
+				if err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "stopping AppConfig Deployment (%s): %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
 	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
 }
 
@@ -158,15 +200,9 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): %s", d.Id(), err)
 	}
 
-	input := &appconfig.GetDeploymentInput{
-		ApplicationId:    aws.String(appID),
-		DeploymentNumber: aws.Int32(deploymentNum),
-		EnvironmentId:    aws.String(envID),
-	}
-
-	output, err := conn.GetDeployment(ctx, input)
+	output, err := findDeploymentByThreePartKey(ctx, conn, appID, envID, deploymentNum)
 
-	if !d.IsNewResource() && errs.IsA[*awstypes.ResourceNotFoundException](err) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Appconfig Deployment (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
@@ -176,10 +212,6 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): %s", d.Id(), err)
 	}
 
-	if output == nil {
-		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): empty response", d.Id())
-	}
-
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -231,3 +263,81 @@ func DeploymentParseID(id string) (string, string, int32, error) {
 
 	return parts[0], parts[1], int32(num), nil
 }
+
+func findDeploymentByThreePartKey(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32) (*appconfig.GetDeploymentOutput, error) {
+	input := &appconfig.GetDeploymentInput{
+		ApplicationId:    aws.String(appID),
+		DeploymentNumber: aws.Int32(deploymentNum),
+		EnvironmentId:    aws.String(envID),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDeployment(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDeploymentByThreePartKey(ctx, conn, appID, envID, deploymentNum)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitDeploymentCompleted(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32, timeout time.Duration) (*appconfig.GetDeploymentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DeploymentStateBaking, awstypes.DeploymentStateDeploying, awstypes.DeploymentStateRollingBack, awstypes.DeploymentStateValidating),
+		Target:     enum.Slice(awstypes.DeploymentStateComplete),
+		Refresh:    statusDeployment(ctx, conn, appID, envID, deploymentNum),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appconfig.GetDeploymentOutput); ok {
+		if output.State == awstypes.DeploymentStateRolledBack {
+			tfresource.SetLastError(err, errors.New(deploymentEventLogString(output.EventLog)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// deploymentEventLogString returns the deployment's event log, oldest event first, as a single string.
+func deploymentEventLogString(apiObjects []awstypes.DeploymentEvent) string {
+	events := make([]string, 0, len(apiObjects))
+
+	// The event log is returned newest event first.
+	for i := len(apiObjects) - 1; i >= 0; i-- {
+		apiObject := apiObjects[i]
+		events = append(events, fmt.Sprintf("%s (%s): %s", apiObject.EventType, apiObject.TriggeredBy, aws.ToString(apiObject.Description)))
+	}
+
+	return strings.Join(events, "; ")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"mime"
 	"strconv"
 	"strings"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/appconfig"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 // @SDKResource("aws_appconfig_hosted_configuration_version")
@@ -83,12 +86,30 @@ func resourceHostedConfigurationVersionCreate(ctx context.Context, d *schema.Res
 
 	appID := d.Get(names.AttrApplicationID).(string)
 	profileID := d.Get("configuration_profile_id").(string)
+	content := []byte(d.Get(names.AttrContent).(string))
+	contentType := d.Get(names.AttrContentType).(string)
+
+	// Catch JSON content that would fail the configuration profile's JSON Schema validators before it is uploaded.
+	if isJSONContentType(contentType) {
+		profile, err := findConfigurationProfileByApplicationAndProfile(ctx, conn, appID, profileID)
+
+		switch {
+		case tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException):
+			diags = sdkdiag.AppendWarningf(diags, "unable to read AppConfig Configuration Profile (%s/%s), skipping validation of content: %s", appID, profileID, err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading AppConfig Configuration Profile (%s/%s): %s", appID, profileID, err)
+		default:
+			if err := validateHostedConfigurationVersionContent(content, profile.Validators); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating AppConfig HostedConfigurationVersion for Application (%s): %s", appID, err)
+			}
+		}
+	}
 
 	input := &appconfig.CreateHostedConfigurationVersionInput{
 		ApplicationId:          aws.String(appID),
 		ConfigurationProfileId: aws.String(profileID),
-		Content:                []byte(d.Get(names.AttrContent).(string)),
-		ContentType:            aws.String(d.Get(names.AttrContentType).(string)),
+		Content:                content,
+		ContentType:            aws.String(contentType),
 	}
 
 	if v, ok := d.GetOk(names.AttrDescription); ok {
@@ -199,3 +220,47 @@ func HostedConfigurationVersionParseID(id string) (string, string, int32, error)
 
 	return parts[0], parts[1], int32(version), nil
 }
+
+// isJSONContentType returns whether the MIME type describes JSON content, e.g. "application/json" or "application/vnd.api+json".
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// validateHostedConfigurationVersionContent validates content against each JSON_SCHEMA validator.
+// Other validator types (e.g. LAMBDA) are only run by AppConfig.
+func validateHostedConfigurationVersionContent(content []byte, validators []awstypes.Validator) error {
+	for _, validator := range validators {
+		if validator.Type != awstypes.ValidatorTypeJsonSchema {
+			continue
+		}
+
+		jsonSchema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(aws.ToString(validator.Content)))
+
+		if err != nil {
+			return fmt.Errorf("loading JSON Schema validator: %w", err)
+		}
+
+		result, err := jsonSchema.Validate(gojsonschema.NewBytesLoader(content))
+
+		if err != nil {
+			return fmt.Errorf("validating content against JSON Schema validator: %w", err)
+		}
+
+		if !result.Valid() {
+			var messages []string
+			for _, v := range result.Errors() {
+				messages = append(messages, v.String())
+			}
+
+			return fmt.Errorf("content does not conform to JSON Schema validator: %s", strings.Join(messages, "; "))
+		}
+	}
+
+	return nil
+}
@@ -78,6 +78,115 @@ func TestAccAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigHostedConfigurationVersion_jsonSchemaValidator(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHostedConfigurationVersionConfig_jsonSchemaValidator(rName, `"bar"`),
+				ExpectError: regexache.MustCompile(`content does not conform to JSON Schema validator`),
+			},
+			{
+				Config: testAccHostedConfigurationVersionConfig_jsonSchemaValidator(rName, acctest.CtTrue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrContent, "{\"foo\":true}"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateHostedConfigurationVersionContent(t *testing.T) {
+	t.Parallel()
+
+	jsonSchema := awstypes.Validator{
+		Content: aws.String(`{"type":"object","properties":{"foo":{"type":"boolean"}},"required":["foo"]}`),
+		Type:    awstypes.ValidatorTypeJsonSchema,
+	}
+	lambda := awstypes.Validator{
+		Content: aws.String("arn:aws:lambda:us-west-2:123456789012:function:example"), //lintignore:AWSAT003,AWSAT005
+		Type:    awstypes.ValidatorTypeLambda,
+	}
+
+	testCases := map[string]struct {
+		content     string
+		validators  []awstypes.Validator
+		expectError bool
+	}{
+		"no validators": {
+			content: `not JSON`,
+		},
+		"lambda validator only": {
+			content:    `not JSON`,
+			validators: []awstypes.Validator{lambda},
+		},
+		"valid": {
+			content:    `{"foo":true}`,
+			validators: []awstypes.Validator{jsonSchema, lambda},
+		},
+		"wrong type": {
+			content:     `{"foo":"bar"}`,
+			validators:  []awstypes.Validator{jsonSchema},
+			expectError: true,
+		},
+		"missing property": {
+			content:     `{}`,
+			validators:  []awstypes.Validator{jsonSchema},
+			expectError: true,
+		},
+		"not JSON": {
+			content:     `not JSON`,
+			validators:  []awstypes.Validator{jsonSchema},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfappconfig.ValidateHostedConfigurationVersionContent([]byte(testCase.content), testCase.validators)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateHostedConfigurationVersionContent() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func TestIsJSONContentType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"application/json":                true,
+		"application/json; charset=utf-8": true,
+		"Application/JSON":                true,
+		"application/vnd.api+json":        true,
+		"application/x-yaml":              false,
+		"text/plain":                      false,
+		"":                                false,
+	}
+
+	for contentType, want := range testCases {
+		t.Run(contentType, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfappconfig.IsJSONContentType(contentType); got != want {
+				t.Errorf("IsJSONContentType(%q) = %t, want %t", contentType, got, want)
+			}
+		})
+	}
+}
+
 func testAccCheckHostedConfigurationVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
@@ -172,3 +281,21 @@ resource "aws_appconfig_hosted_configuration_version" "test" {
 }
 `, rName))
 }
+
+func testAccHostedConfigurationVersionConfig_jsonSchemaValidator(rName, value string) string {
+	return acctest.ConfigCompose(
+		testAccConfigurationProfileConfig_validatorJSON(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+
+  content = jsonencode({
+    foo = %[2]s
+  })
+
+  description = %[1]q
+}
+`, rName, value))
+}
@@ -5,6 +5,11 @@ package backup
 
 // Exports for use in tests only.
 var (
+	ResourceJob        = resourceJob
+	ResourceRestoreJob = resourceRestoreJob
+
+	FindJobByID                 = findJobByID
+	FindRestoreJobByID          = findRestoreJobByID
 	FindVaultAccessPolicyByName = findVaultAccessPolicyByName
 	FindVaultByName             = findVaultByName
 )
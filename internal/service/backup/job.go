@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_backup_job", name="Job")
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_options": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"backup_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"backup_vault_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]{2,50}$`), "must consist of letters, numbers, and hyphens."),
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completion_window": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrIAMRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"recovery_point_lifecycle": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cold_storage_after": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"delete_after": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"opt_in_to_archive_for_supported_resources": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"recovery_point_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recovery_point_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrResourceType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_window": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	resourceARN := d.Get(names.AttrResourceARN).(string)
+	input := &backup.StartBackupJobInput{
+		BackupVaultName:  aws.String(d.Get("backup_vault_name").(string)),
+		IamRoleArn:       aws.String(d.Get(names.AttrIAMRoleARN).(string)),
+		IdempotencyToken: aws.String(id.UniqueId()),
+		ResourceArn:      aws.String(resourceARN),
+	}
+
+	if v, ok := d.GetOk("backup_options"); ok && len(v.(map[string]interface{})) > 0 {
+		input.BackupOptions = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("completion_window"); ok {
+		input.CompleteWindowMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("recovery_point_lifecycle"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Lifecycle = expandPlanLifecycle(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("recovery_point_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.RecoveryPointTags = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("start_window"); ok {
+		input.StartWindowMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.StartBackupJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Backup Job (%s): %s", resourceARN, err)
+	}
+
+	d.SetId(aws.ToString(output.BackupJobId))
+
+	if _, err := WaitJobCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Backup Job (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	output, err := findJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Backup Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("backup_options", output.BackupOptions)
+	d.Set("backup_size_in_bytes", output.BackupSizeInBytes)
+	d.Set("backup_vault_arn", output.BackupVaultArn)
+	d.Set("backup_vault_name", output.BackupVaultName)
+	if output.CompletionDate != nil {
+		d.Set("completion_date", aws.ToTime(output.CompletionDate).Format(time.RFC3339))
+	} else {
+		d.Set("completion_date", nil)
+	}
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set(names.AttrIAMRoleARN, output.IamRoleArn)
+	d.Set("recovery_point_arn", output.RecoveryPointArn)
+	d.Set(names.AttrResourceARN, output.ResourceArn)
+	d.Set(names.AttrResourceType, output.ResourceType)
+	d.Set(names.AttrState, output.State)
+
+	return diags
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A completed backup job cannot be deleted. The recovery point is retained according to its lifecycle.
+	log.Printf("[WARN] Backup Job (%s) removed from state, recovery point (%s) retained", d.Id(), d.Get("recovery_point_arn").(string))
+
+	return diags
+}
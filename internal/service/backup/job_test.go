@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.DescribeBackupJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_vault_arn", "aws_backup_vault.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_vault_name", "aws_backup_vault.test", names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, "completion_date"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIAMRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_lifecycle.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_lifecycle.0.delete_after", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "recovery_point_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, "aws_dynamodb_table.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "DynamoDB"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "COMPLETED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"completion_window", "recovery_point_lifecycle", "recovery_point_tags", "start_window"},
+			},
+		},
+	})
+}
+
+func testAccCheckJobExists(ctx context.Context, n string, v *backup.DescribeBackupJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupClient(ctx)
+
+		output, err := tfbackup.FindJobByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_backup_vault" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "backup.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "backup" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForBackup"
+}
+
+resource "aws_iam_role_policy_attachment" "restore" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForRestores"
+}
+`, rName)
+}
+
+func testAccJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_backup_job" "test" {
+  backup_vault_name = aws_backup_vault.test.name
+  iam_role_arn      = aws_iam_role.test.arn
+  resource_arn      = aws_dynamodb_table.test.arn
+
+  recovery_point_lifecycle {
+    delete_after = 7
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.backup]
+}
+`)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_backup_restore_job", name="Restore Job")
+func resourceRestoreJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRestoreJobCreate,
+		ReadWithoutTimeout:   resourceRestoreJobRead,
+		DeleteWithoutTimeout: resourceRestoreJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"copy_source_tags_to_restored_resource": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"created_resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrIAMRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"recovery_point_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrResourceType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRestoreJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	recoveryPointARN := d.Get("recovery_point_arn").(string)
+	input := &backup.StartRestoreJobInput{
+		CopySourceTagsToRestoredResource: d.Get("copy_source_tags_to_restored_resource").(bool),
+		IdempotencyToken:                 aws.String(id.UniqueId()),
+		Metadata:                         flex.ExpandStringValueMap(d.Get("metadata").(map[string]interface{})),
+		RecoveryPointArn:                 aws.String(recoveryPointARN),
+	}
+
+	if v, ok := d.GetOk(names.AttrIAMRoleARN); ok {
+		input.IamRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrResourceType); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	output, err := conn.StartRestoreJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Backup Restore Job (%s): %s", recoveryPointARN, err)
+	}
+
+	d.SetId(aws.ToString(output.RestoreJobId))
+
+	if _, err := waitRestoreJobCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Backup Restore Job (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRestoreJobRead(ctx, d, meta)...)
+}
+
+func resourceRestoreJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	output, err := findRestoreJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Restore Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Backup Restore Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("backup_size_in_bytes", output.BackupSizeInBytes)
+	if output.CompletionDate != nil {
+		d.Set("completion_date", aws.ToTime(output.CompletionDate).Format(time.RFC3339))
+	} else {
+		d.Set("completion_date", nil)
+	}
+	d.Set("created_resource_arn", output.CreatedResourceArn)
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set(names.AttrIAMRoleARN, output.IamRoleArn)
+	d.Set("recovery_point_arn", output.RecoveryPointArn)
+	d.Set(names.AttrResourceType, output.ResourceType)
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func resourceRestoreJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A completed restore job cannot be deleted. The restored resource is not managed by this resource.
+	log.Printf("[WARN] Backup Restore Job (%s) removed from state, restored resource (%s) retained", d.Id(), d.Get("created_resource_arn").(string))
+
+	return diags
+}
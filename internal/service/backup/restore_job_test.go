@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupRestoreJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.DescribeRestoreJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_restore_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreJobExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "created_resource_arn", "dynamodb", fmt.Sprintf("table/%s-restored", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIAMRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "recovery_point_arn", "aws_backup_job.test", "recovery_point_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "COMPLETED"),
+					// The restored table is not managed by Terraform.
+					testAccCheckRestoreJobDeleteCreatedDynamoDBTable(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRestoreJobExists(ctx context.Context, n string, v *backup.DescribeRestoreJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupClient(ctx)
+
+		output, err := tfbackup.FindRestoreJobByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRestoreJobDeleteCreatedDynamoDBTable(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		_, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{
+			TableName: aws.String(rs.Primary.Attributes["created_resource_arn"]),
+		})
+
+		return err
+	}
+}
+
+func testAccRestoreJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_basic(rName), fmt.Sprintf(`
+resource "aws_backup_restore_job" "test" {
+  recovery_point_arn = aws_backup_job.test.recovery_point_arn
+  iam_role_arn       = aws_iam_role.test.arn
+
+  metadata = {
+    targetTableName = "%[1]s-restored"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.restore]
+}
+`, rName))
+}
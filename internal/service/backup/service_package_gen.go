@@ -61,6 +61,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalSettings,
 			TypeName: "aws_backup_global_settings",
 		},
+		{
+			Factory:  resourceJob,
+			TypeName: "aws_backup_job",
+			Name:     "Job",
+		},
 		{
 			Factory:  ResourcePlan,
 			TypeName: "aws_backup_plan",
@@ -81,6 +86,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceRestoreJob,
+			TypeName: "aws_backup_restore_job",
+			Name:     "Restore Job",
+		},
 		{
 			Factory:  ResourceSelection,
 			TypeName: "aws_backup_selection",
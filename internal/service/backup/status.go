@@ -28,6 +28,22 @@ func statusJobState(ctx context.Context, conn *backup.Client, id string) retry.S
 	}
 }
 
+func statusRestoreJob(ctx context.Context, conn *backup.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRestoreJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
 func statusFramework(ctx context.Context, conn *backup.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findFrameworkByName(ctx, conn, id)
@@ -40,6 +40,25 @@ func WaitJobCompleted(ctx context.Context, conn *backup.Client, id string, timeo
 	return nil, err
 }
 
+func waitRestoreJobCompleted(ctx context.Context, conn *backup.Client, id string, timeout time.Duration) (*backup.DescribeRestoreJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.RestoreJobStatusPending, awstypes.RestoreJobStatusRunning),
+		Target:  enum.Slice(awstypes.RestoreJobStatusCompleted),
+		Refresh: statusRestoreJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*backup.DescribeRestoreJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitFrameworkCreated(ctx context.Context, conn *backup.Client, id string, timeout time.Duration) (*backup.DescribeFrameworkOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{frameworkStatusCreationInProgress},
@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_batch_job", name="Job")
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"array_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSize: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(2, 10000),
+						},
+					},
+				},
+			},
+			"container_overrides": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validJobContainerOverrides,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				ConflictsWith:    []string{"node_overrides"},
+			},
+			"job_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_dependency": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(batch.ArrayJobDependency_Values(), false),
+						},
+					},
+				},
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_queue": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]{1}[0-9A-Za-z_-]*$`), "must start with a letter or number, and can contain letters, numbers, hyphens and underscores"),
+				),
+			},
+			"node_overrides": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validJobNodeOverrides,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				ConflictsWith:    []string{"container_overrides"},
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrPropagateTags: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retry_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+						"evaluate_on_exit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAction: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										StateFunc: func(v interface{}) string {
+											return strings.ToLower(v.(string))
+										},
+										ValidateFunc: validation.StringInSlice(batch.RetryAction_Values(), true),
+									},
+									"on_exit_code": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"on_reason": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"on_status_reason": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"scheduling_priority_override": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 9999),
+			},
+			"share_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusReason: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stopped_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTimeout: {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attempt_duration_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BatchConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &batch.SubmitJobInput{
+		JobDefinition: aws.String(d.Get("job_definition").(string)),
+		JobName:       aws.String(name),
+		JobQueue:      aws.String(d.Get("job_queue").(string)),
+	}
+
+	if v, ok := d.GetOk("array_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ArrayProperties = &batch.ArrayProperties{
+			Size: aws.Int64(int64(v.([]interface{})[0].(map[string]interface{})[names.AttrSize].(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("container_overrides"); ok {
+		overrides, err := expandJobContainerOverrides(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "submitting Batch Job (%s): %s", name, err)
+		}
+
+		input.ContainerOverrides = overrides
+	}
+
+	if v, ok := d.GetOk("job_dependency"); ok && len(v.([]interface{})) > 0 {
+		input.DependsOn = expandJobDependencies(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("node_overrides"); ok {
+		overrides, err := expandJobNodeOverrides(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "submitting Batch Job (%s): %s", name, err)
+		}
+
+		input.NodeOverrides = overrides
+	}
+
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.(map[string]interface{})) > 0 {
+		input.Parameters = expandJobDefinitionParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrPropagateTags); ok {
+		input.PropagateTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("retry_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetryStrategy = expandRetryStrategy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("scheduling_priority_override"); ok {
+		input.SchedulingPriorityOverride = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("share_identifier"); ok {
+		input.ShareIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrTimeout); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Timeout = expandJobTimeout(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.SubmitJobWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "submitting Batch Job (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	if _, err := waitJobSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "waiting for Batch Job (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BatchConn(ctx)
+
+	job, err := FindJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// Job information is only retained for a limited time after a job completes.
+		// Keep the recorded result rather than submitting the job again.
+		log.Printf("[WARN] Batch Job (%s) not found, keeping recorded result", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Batch Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, job.JobArn)
+	d.Set("job_id", job.JobId)
+	d.Set(names.AttrName, job.JobName)
+	d.Set("started_at", flattenJobTimestamp(job.StartedAt))
+	d.Set(names.AttrStatus, job.Status)
+	d.Set(names.AttrStatusReason, job.StatusReason)
+	d.Set("stopped_at", flattenJobTimestamp(job.StoppedAt))
+
+	return diags
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BatchConn(ctx)
+
+	job, err := FindJobByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Batch Job (%s): %s", d.Id(), err)
+	}
+
+	// Completed jobs cannot be deleted; only terminate a job that has not yet finished.
+	switch aws.StringValue(job.Status) {
+	case batch.JobStatusSucceeded, batch.JobStatusFailed:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Terminating Batch Job: %s", d.Id())
+	_, err = conn.TerminateJobWithContext(ctx, &batch.TerminateJobInput{
+		JobId:  aws.String(d.Id()),
+		Reason: aws.String("Terminated by Terraform"),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "terminating Batch Job (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindJobByID(ctx context.Context, conn *batch.Batch, id string) (*batch.JobDetail, error) {
+	input := &batch.DescribeJobsInput{
+		Jobs: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeJobsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Jobs) == 0 || output.Jobs[0] == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output.Jobs); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Jobs[0], nil
+}
+
+func statusJob(ctx context.Context, conn *batch.Batch, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitJobSucceeded(ctx context.Context, conn *batch.Batch, id string, timeout time.Duration) (*batch.JobDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			batch.JobStatusSubmitted,
+			batch.JobStatusPending,
+			batch.JobStatusRunnable,
+			batch.JobStatusStarting,
+			batch.JobStatusRunning,
+		},
+		Target:  []string{batch.JobStatusSucceeded},
+		Refresh: statusJob(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*batch.JobDetail); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func validJobContainerOverrides(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := expandJobContainerOverrides(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("AWS Batch Job container_overrides is invalid: %s", err))
+	}
+	return
+}
+
+func expandJobContainerOverrides(rawOverrides string) (*batch.ContainerOverrides, error) {
+	var overrides *batch.ContainerOverrides
+
+	err := json.Unmarshal([]byte(rawOverrides), &overrides)
+	if err != nil {
+		return nil, fmt.Errorf("decoding JSON: %s", err)
+	}
+
+	return overrides, nil
+}
+
+func validJobNodeOverrides(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := expandJobNodeOverrides(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("AWS Batch Job node_overrides is invalid: %s", err))
+	}
+	return
+}
+
+func expandJobNodeOverrides(rawOverrides string) (*batch.NodeOverrides, error) {
+	var overrides *batch.NodeOverrides
+
+	err := json.Unmarshal([]byte(rawOverrides), &overrides)
+	if err != nil {
+		return nil, fmt.Errorf("decoding JSON: %s", err)
+	}
+
+	return overrides, nil
+}
+
+func expandJobDependencies(tfList []interface{}) []*batch.JobDependency {
+	var apiObjects []*batch.JobDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.JobDependency{
+			JobId: aws.String(tfMap["job_id"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenJobTimestamp converts a Unix timestamp in milliseconds to RFC 3339 format.
+func flattenJobTimestamp(v *int64) string {
+	if v == nil {
+		return ""
+	}
+
+	return time.UnixMilli(aws.Int64Value(v)).UTC().Format(time.RFC3339)
+}
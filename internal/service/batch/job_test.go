@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/batch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBatchJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job batch.JobDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName, `["echo", "hello"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "batch", regexache.MustCompile(`job/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "started_at"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, batch.JobStatusSucceeded),
+					resource.TestCheckResourceAttrSet(resourceName, "stopped_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchJob_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_basic(rName, `["sh", "-c", "exit 1"]`),
+				ExpectError: regexache.MustCompile(`unexpected state 'FAILED', wanted target 'SUCCEEDED'`),
+			},
+		},
+	})
+}
+
+func TestAccBatchJob_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job1, job2 batch.JobDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_triggers(rName, "a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.seed", "a"),
+				),
+			},
+			{
+				Config: testAccJobConfig_triggers(rName, "b"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobRecreated(&job1, &job2),
+					testAccCheckJobExists(ctx, resourceName, &job2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.seed", "b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobExists(ctx context.Context, n string, v *batch.JobDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BatchConn(ctx)
+
+		output, err := tfbatch.FindJobByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJobRecreated(before, after *batch.JobDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.JobId != nil && after.JobId != nil && *before.JobId == *after.JobId {
+			return fmt.Errorf("Batch Job (%s) not recreated", *before.JobId)
+		}
+
+		return nil
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  route_table_id = aws_route_table.test.id
+  subnet_id      = aws_subnet.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+data "aws_iam_policy_document" "ecs_tasks_assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ecs-tasks.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "ecs_task_execution_role" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.ecs_tasks_assume_role.json
+}
+
+resource "aws_iam_role_policy_attachment" "ecs_task_execution_role_policy" {
+  role       = aws_iam_role.ecs_task_execution_role.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy"
+}
+
+resource "aws_batch_compute_environment" "test" {
+  compute_environment_name = %[1]q
+  type                     = "MANAGED"
+
+  compute_resources {
+    max_vcpus          = 1
+    security_group_ids = [aws_security_group.test.id]
+    subnets            = [aws_subnet.test.id]
+    type               = "FARGATE"
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+
+resource "aws_batch_job_queue" "test" {
+  compute_environments = [aws_batch_compute_environment.test.arn]
+  name                 = %[1]q
+  priority             = 1
+  state                = "ENABLED"
+}
+
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  platform_capabilities = ["FARGATE"]
+
+  container_properties = jsonencode({
+    command = ["echo", "test"]
+    image   = "public.ecr.aws/docker/library/busybox:latest"
+    networkConfiguration = {
+      assignPublicIp = "ENABLED"
+    }
+    resourceRequirements = [
+      { type = "VCPU", value = "0.25" },
+      { type = "MEMORY", value = "512" },
+    ]
+    executionRoleArn = aws_iam_role.ecs_task_execution_role.arn
+  })
+
+  depends_on = [aws_iam_role_policy_attachment.ecs_task_execution_role_policy]
+}
+`, rName))
+}
+
+func testAccJobConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+
+  container_overrides = jsonencode({
+    command = %[2]s
+  })
+
+  timeout {
+    attempt_duration_seconds = 600
+  }
+}
+`, rName, command))
+}
+
+func testAccJobConfig_triggers(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+
+  parameters = {
+    seed = %[2]q
+  }
+
+  retry_strategy {
+    attempts = 2
+  }
+
+  triggers = {
+    seed = %[2]q
+  }
+}
+`, rName, trigger))
+}
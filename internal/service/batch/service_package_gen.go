@@ -72,6 +72,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceJob,
+			TypeName: "aws_batch_job",
+			Name:     "Job",
+		},
 		{
 			Factory:  ResourceJobDefinition,
 			TypeName: "aws_batch_job_definition",
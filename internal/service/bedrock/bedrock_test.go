@@ -24,6 +24,17 @@ func TestAccBedrock_serial(t *testing.T) {
 			"vpcConfig":                             testAccBedrockCustomModel_vpcConfig,
 			"dataSourceBasic":                       testAccBedrockCustomModelDataSource_basic,
 		},
+		"EvaluationJob": {
+			acctest.CtBasic:      testAccEvaluationJob_basic,
+			acctest.CtDisappears: testAccEvaluationJob_disappears,
+			"tags":               testAccEvaluationJob_tags,
+		},
+		"ModelInvocationJob": {
+			acctest.CtBasic:      testAccModelInvocationJob_basic,
+			acctest.CtDisappears: testAccModelInvocationJob_disappears,
+			"tags":               testAccModelInvocationJob_tags,
+			"vpcConfig":          testAccModelInvocationJob_vpcConfig,
+		},
 		"ModelInvocationLoggingConfiguration": {
 			acctest.CtBasic:      testAccModelInvocationLoggingConfiguration_basic,
 			acctest.CtDisappears: testAccModelInvocationLoggingConfiguration_disappears,
@@ -0,0 +1,834 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Evaluation Job")
+// @Tags(identifierAttribute="arn")
+func newEvaluationJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &evaluationJobResource{}
+
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type evaluationJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[evaluationJobResourceModel]
+	framework.WithTimeouts
+}
+
+func (*evaluationJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_evaluation_job"
+}
+
+func (r *evaluationJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	datasetMetricConfigBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationDatasetMetricConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(5),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"metric_names": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+					Validators: []validator.List{
+						listvalidator.SizeBetween(1, 10),
+					},
+				},
+				"task_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.EvaluationTaskType](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"dataset": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationDatasetModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrName: schema.StringAttribute{
+								Required: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 63),
+								},
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"dataset_location": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationDatasetLocationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"s3_uri": schema.StringAttribute{
+											Required: true,
+											Validators: []validator.String{
+												fwvalidators.S3URI(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_id": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"failure_messages": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationJobType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z0-9](-*[a-z0-9]){0,62}$`),
+						"must be up to 63 lowercase letters, numbers and dashes, and must start and end with a lowercase letter or number"),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationJobStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"evaluation_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"automated": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[automatedEvaluationConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("automated"),
+									path.MatchRelative().AtParent().AtName("human"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"dataset_metric_config": datasetMetricConfigBlock,
+								},
+							},
+						},
+						"human": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[humanEvaluationConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"custom_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[humanEvaluationCustomMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(10),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDescription: schema.StringAttribute{
+													Optional: true,
+												},
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+												"rating_method": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"dataset_metric_config": datasetMetricConfigBlock,
+									"human_workflow_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[humanWorkflowConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"flow_definition_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+												"instructions": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 5000),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"inference_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationInferenceConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"model": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationModelConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(2),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"bedrock_model": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationBedrockModelModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"inference_params": schema.StringAttribute{
+													Required: true,
+												},
+												"model_identifier": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationOutputDataConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_uri": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.S3URI(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *evaluationJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data evaluationJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	input := &bedrock.CreateEvaluationJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.JobTags = getTagsIn(ctx)
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateEvaluationJob(ctx, input)
+	}, errCodeValidationException, "Could not assume provided IAM role")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Evaluation Job (%s)", data.JobName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.JobARN = fwflex.StringToFramework(ctx, outputRaw.(*bedrock.CreateEvaluationJobOutput).JobArn)
+	data.setID()
+
+	var job *bedrock.GetEvaluationJobOutput
+	if data.WaitForCompletion.ValueBool() {
+		job, err = waitEvaluationJobCompleted(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+	} else {
+		job, err = findEvaluationJobByARN(ctx, conn, data.ID.ValueString())
+	}
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Evaluation Job (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CreationTime = fwflex.TimeToFramework(ctx, job.CreationTime)
+	data.FailureMessages = fwflex.FlattenFrameworkStringValueListOfString(ctx, job.FailureMessages)
+	data.JobType = fwtypes.StringEnumValue(job.JobType)
+	data.Status = fwtypes.StringEnumValue(job.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *evaluationJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data evaluationJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	output, err := findEvaluationJobByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Evaluation Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Some fields in GetEvaluationJobOutput have different names than in CreateEvaluationJobInput.
+	data.CreationTime = fwflex.TimeToFramework(ctx, output.CreationTime)
+	data.FailureMessages = fwflex.FlattenFrameworkStringValueListOfString(ctx, output.FailureMessages)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *evaluationJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data evaluationJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	if data.Status.ValueEnum() != awstypes.EvaluationJobStatusInProgress {
+		// Finished evaluation jobs cannot be deleted. The results remain in Amazon S3.
+		tflog.Warn(ctx, "Bedrock Evaluation Job removed from state", map[string]any{
+			names.AttrID:     data.ID.ValueString(),
+			names.AttrStatus: data.Status.ValueString(),
+		})
+
+		return
+	}
+
+	_, err := conn.StopEvaluationJob(ctx, &bedrock.StopEvaluationJobInput{
+		JobIdentifier: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	// The job may have finished, or already be stopping, since it was last read.
+	if errs.IsA[*awstypes.ConflictException](err) || errs.IsA[*awstypes.ValidationException](err) {
+		output, findErr := findEvaluationJob(ctx, conn, &bedrock.GetEvaluationJobInput{
+			JobIdentifier: aws.String(data.ID.ValueString()),
+		})
+
+		if tfresource.NotFound(findErr) {
+			return
+		}
+
+		if findErr == nil && output.Status != awstypes.EvaluationJobStatusInProgress {
+			err = nil
+		}
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping Bedrock Evaluation Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitEvaluationJobStopped(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Evaluation Job (%s) stop", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *evaluationJobResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findEvaluationJobByARN(ctx context.Context, conn *bedrock.Client, arn string) (*bedrock.GetEvaluationJobOutput, error) {
+	input := &bedrock.GetEvaluationJobInput{
+		JobIdentifier: aws.String(arn),
+	}
+
+	output, err := findEvaluationJob(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.EvaluationJobStatusStopped {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findEvaluationJob(ctx context.Context, conn *bedrock.Client, input *bedrock.GetEvaluationJobInput) (*bedrock.GetEvaluationJobOutput, error) {
+	output, err := conn.GetEvaluationJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEvaluationJob(ctx context.Context, conn *bedrock.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &bedrock.GetEvaluationJobInput{
+			JobIdentifier: aws.String(arn),
+		}
+		output, err := findEvaluationJob(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEvaluationJobCompleted(ctx context.Context, conn *bedrock.Client, arn string, timeout time.Duration) (*bedrock.GetEvaluationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.EvaluationJobStatusInProgress),
+		Target:       enum.Slice(awstypes.EvaluationJobStatusCompleted),
+		Refresh:      statusEvaluationJob(ctx, conn, arn),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetEvaluationJobOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureMessages, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEvaluationJobStopped(ctx context.Context, conn *bedrock.Client, arn string, timeout time.Duration) (*bedrock.GetEvaluationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EvaluationJobStatusInProgress, awstypes.EvaluationJobStatusStopping),
+		Target:  enum.Slice(awstypes.EvaluationJobStatusCompleted, awstypes.EvaluationJobStatusFailed, awstypes.EvaluationJobStatusStopped),
+		Refresh: statusEvaluationJob(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetEvaluationJobOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureMessages, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type evaluationJobResourceModel struct {
+	CreationTime            timetypes.RFC3339                                                `tfsdk:"creation_time"`
+	CustomerEncryptionKeyID fwtypes.ARN                                                      `tfsdk:"customer_encryption_key_id"`
+	EvaluationConfig        fwtypes.ListNestedObjectValueOf[evaluationConfigModel]           `tfsdk:"evaluation_config"`
+	FailureMessages         fwtypes.ListValueOf[types.String]                                `tfsdk:"failure_messages"`
+	ID                      types.String                                                     `tfsdk:"id"`
+	InferenceConfig         fwtypes.ListNestedObjectValueOf[evaluationInferenceConfigModel]  `tfsdk:"inference_config"`
+	JobARN                  types.String                                                     `tfsdk:"arn"`
+	JobDescription          types.String                                                     `tfsdk:"description"`
+	JobName                 types.String                                                     `tfsdk:"name"`
+	JobType                 fwtypes.StringEnum[awstypes.EvaluationJobType]                   `tfsdk:"job_type"`
+	OutputDataConfig        fwtypes.ListNestedObjectValueOf[evaluationOutputDataConfigModel] `tfsdk:"output_data_config"`
+	RoleARN                 fwtypes.ARN                                                      `tfsdk:"role_arn"`
+	Status                  fwtypes.StringEnum[awstypes.EvaluationJobStatus]                 `tfsdk:"status"`
+	Tags                    types.Map                                                        `tfsdk:"tags"`
+	TagsAll                 types.Map                                                        `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                                   `tfsdk:"timeouts"`
+	WaitForCompletion       types.Bool                                                       `tfsdk:"wait_for_completion"`
+}
+
+func (data *evaluationJobResourceModel) InitFromID() error {
+	data.JobARN = data.ID
+
+	return nil
+}
+
+func (data *evaluationJobResourceModel) setID() {
+	data.ID = data.JobARN
+}
+
+type evaluationConfigModel struct {
+	Automated fwtypes.ListNestedObjectValueOf[automatedEvaluationConfigModel] `tfsdk:"automated"`
+	Human     fwtypes.ListNestedObjectValueOf[humanEvaluationConfigModel]     `tfsdk:"human"`
+}
+
+var (
+	_ fwflex.Expander  = evaluationConfigModel{}
+	_ fwflex.Flattener = &evaluationConfigModel{}
+)
+
+func (m evaluationConfigModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Automated.IsNull():
+		automatedEvaluationConfigData := fwdiag.Must(m.Automated.ToPtr(ctx))
+
+		var r awstypes.EvaluationConfigMemberAutomated
+		diags.Append(fwflex.Expand(ctx, automatedEvaluationConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Human.IsNull():
+		humanEvaluationConfigData := fwdiag.Must(m.Human.ToPtr(ctx))
+
+		var r awstypes.EvaluationConfigMemberHuman
+		diags.Append(fwflex.Expand(ctx, humanEvaluationConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *evaluationConfigModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.Automated = fwtypes.NewListNestedObjectValueOfNull[automatedEvaluationConfigModel](ctx)
+	m.Human = fwtypes.NewListNestedObjectValueOfNull[humanEvaluationConfigModel](ctx)
+
+	switch t := v.(type) {
+	case *awstypes.EvaluationConfigMemberAutomated:
+		var model automatedEvaluationConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Automated = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case *awstypes.EvaluationConfigMemberHuman:
+		var model humanEvaluationConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Human = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type automatedEvaluationConfigModel struct {
+	DatasetMetricConfigs fwtypes.ListNestedObjectValueOf[evaluationDatasetMetricConfigModel] `tfsdk:"dataset_metric_config"`
+}
+
+type humanEvaluationConfigModel struct {
+	CustomMetrics        fwtypes.ListNestedObjectValueOf[humanEvaluationCustomMetricModel]   `tfsdk:"custom_metric"`
+	DatasetMetricConfigs fwtypes.ListNestedObjectValueOf[evaluationDatasetMetricConfigModel] `tfsdk:"dataset_metric_config"`
+	HumanWorkflowConfig  fwtypes.ListNestedObjectValueOf[humanWorkflowConfigModel]           `tfsdk:"human_workflow_config"`
+}
+
+type humanEvaluationCustomMetricModel struct {
+	Description  types.String `tfsdk:"description"`
+	Name         types.String `tfsdk:"name"`
+	RatingMethod types.String `tfsdk:"rating_method"`
+}
+
+type humanWorkflowConfigModel struct {
+	FlowDefinitionARN fwtypes.ARN  `tfsdk:"flow_definition_arn"`
+	Instructions      types.String `tfsdk:"instructions"`
+}
+
+type evaluationDatasetMetricConfigModel struct {
+	Dataset     fwtypes.ListNestedObjectValueOf[evaluationDatasetModel] `tfsdk:"dataset"`
+	MetricNames fwtypes.ListValueOf[types.String]                       `tfsdk:"metric_names"`
+	TaskType    fwtypes.StringEnum[awstypes.EvaluationTaskType]         `tfsdk:"task_type"`
+}
+
+type evaluationDatasetModel struct {
+	DatasetLocation fwtypes.ListNestedObjectValueOf[evaluationDatasetLocationModel] `tfsdk:"dataset_location"`
+	Name            types.String                                                    `tfsdk:"name"`
+}
+
+type evaluationDatasetLocationModel struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
+
+var (
+	_ fwflex.Expander  = evaluationDatasetLocationModel{}
+	_ fwflex.Flattener = &evaluationDatasetLocationModel{}
+)
+
+func (m evaluationDatasetLocationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	if !m.S3URI.IsNull() {
+		return &awstypes.EvaluationDatasetLocationMemberS3Uri{
+			Value: m.S3URI.ValueString(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *evaluationDatasetLocationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case *awstypes.EvaluationDatasetLocationMemberS3Uri:
+		m.S3URI = fwflex.StringValueToFramework(ctx, t.Value)
+	}
+
+	return diags
+}
+
+type evaluationInferenceConfigModel struct {
+	Models fwtypes.ListNestedObjectValueOf[evaluationModelConfigModel] `tfsdk:"model"`
+}
+
+var (
+	_ fwflex.Expander  = evaluationInferenceConfigModel{}
+	_ fwflex.Flattener = &evaluationInferenceConfigModel{}
+)
+
+func (m evaluationInferenceConfigModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	evaluationModelConfigsData, d := m.Models.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var r awstypes.EvaluationInferenceConfigMemberModels
+	for _, evaluationModelConfigData := range evaluationModelConfigsData {
+		evaluationBedrockModelData := fwdiag.Must(evaluationModelConfigData.BedrockModel.ToPtr(ctx))
+
+		r.Value = append(r.Value, &awstypes.EvaluationModelConfigMemberBedrockModel{
+			Value: awstypes.EvaluationBedrockModel{
+				InferenceParams: fwflex.StringFromFramework(ctx, evaluationBedrockModelData.InferenceParams),
+				ModelIdentifier: fwflex.StringFromFramework(ctx, evaluationBedrockModelData.ModelIdentifier),
+			},
+		})
+	}
+
+	return &r, diags
+}
+
+func (m *evaluationInferenceConfigModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case *awstypes.EvaluationInferenceConfigMemberModels:
+		var evaluationModelConfigsData []*evaluationModelConfigModel
+		for _, v := range t.Value {
+			switch t := v.(type) {
+			case *awstypes.EvaluationModelConfigMemberBedrockModel:
+				evaluationModelConfigsData = append(evaluationModelConfigsData, &evaluationModelConfigModel{
+					BedrockModel: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &evaluationBedrockModelModel{
+						InferenceParams: fwflex.StringToFramework(ctx, t.Value.InferenceParams),
+						ModelIdentifier: fwflex.StringToFramework(ctx, t.Value.ModelIdentifier),
+					}),
+				})
+			}
+		}
+
+		m.Models = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, evaluationModelConfigsData)
+	}
+
+	return diags
+}
+
+type evaluationModelConfigModel struct {
+	BedrockModel fwtypes.ListNestedObjectValueOf[evaluationBedrockModelModel] `tfsdk:"bedrock_model"`
+}
+
+type evaluationBedrockModelModel struct {
+	InferenceParams types.String `tfsdk:"inference_params"`
+	ModelIdentifier types.String `tfsdk:"model_identifier"`
+}
+
+type evaluationOutputDataConfigModel struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
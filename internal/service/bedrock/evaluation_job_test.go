@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEvaluationJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_evaluation_job.test"
+	var v bedrock.GetEvaluationJobOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEvaluationJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
+					resource.TestCheckNoResourceAttr(resourceName, "customer_encryption_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.0.dataset_metric_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.0.dataset_metric_config.0.dataset.0.name", "Builtin.BoolQ"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.0.dataset_metric_config.0.metric_names.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.0.dataset_metric_config.0.task_type", "QuestionAndAnswer"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.human.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "inference_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "inference_config.0.model.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_type", "Automated"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "InProgress"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrStatus, "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func testAccEvaluationJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_evaluation_job.test"
+	var v bedrock.GetEvaluationJobOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationJobExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrock.ResourceEvaluationJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEvaluationJob_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_evaluation_job.test"
+	var v bedrock.GetEvaluationJobOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationJobConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccEvaluationJobConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEvaluationJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_evaluation_job" {
+				continue
+			}
+
+			_, err := tfbedrock.FindEvaluationJobByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Evaluation Job %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEvaluationJobExists(ctx context.Context, n string, v *bedrock.GetEvaluationJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		output, err := tfbedrock.FindEvaluationJobByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEvaluationJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_region" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  # See https://docs.aws.amazon.com/bedrock/latest/userguide/model-evaluation-security-service-roles.html.
+  assume_role_policy = jsonencode({
+    "Version" : "2012-10-17",
+    "Statement" : [{
+      "Effect" : "Allow",
+      "Principal" : {
+        "Service" : "bedrock.amazonaws.com"
+      },
+      "Action" : "sts:AssumeRole",
+      "Condition" : {
+        "StringEquals" : {
+          "aws:SourceAccount" : data.aws_caller_identity.current.account_id
+        },
+        "ArnEquals" : {
+          "aws:SourceArn" : "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:evaluation-job/*"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    "Version" : "2012-10-17",
+    "Statement" : [{
+      "Effect" : "Allow",
+      "Action" : [
+        "s3:GetObject",
+        "s3:PutObject",
+        "s3:ListBucket"
+      ],
+      "Resource" : [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+      }, {
+      "Effect" : "Allow",
+      "Action" : [
+        "bedrock:InvokeModel",
+        "bedrock:InvokeModelWithResponseStream"
+      ],
+      "Resource" : data.aws_bedrock_foundation_model.test.model_arn
+    }]
+  })
+}
+
+data "aws_bedrock_foundation_model" "test" {
+  model_id = "amazon.titan-text-express-v1"
+}
+`, rName)
+}
+
+func testAccEvaluationJobConfig_resource(rName, tags string) string {
+	return acctest.ConfigCompose(testAccEvaluationJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrock_evaluation_job" "test" {
+  name                = %[1]q
+  role_arn            = aws_iam_role.test.arn
+  wait_for_completion = false
+
+  evaluation_config {
+    automated {
+      dataset_metric_config {
+        metric_names = ["Builtin.Accuracy"]
+        task_type    = "QuestionAndAnswer"
+
+        dataset {
+          name = "Builtin.BoolQ"
+        }
+      }
+    }
+  }
+
+  inference_config {
+    model {
+      bedrock_model {
+        inference_params = jsonencode({
+          "inferenceConfig" : {
+            "maxTokens" : 512,
+            "temperature" : 0,
+            "topP" : 1
+          }
+        })
+        model_identifier = data.aws_bedrock_foundation_model.test.model_id
+      }
+    }
+  }
+
+  output_data_config {
+    s3_uri = "s3://${aws_s3_bucket.test.id}/output/"
+  }
+
+%[2]s
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tags))
+}
+
+func testAccEvaluationJobConfig_basic(rName string) string {
+	return testAccEvaluationJobConfig_resource(rName, "")
+}
+
+func testAccEvaluationJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccEvaluationJobConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccEvaluationJobConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccEvaluationJobConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -6,10 +6,14 @@ package bedrock
 // Exports for use in tests only.
 var (
 	ResourceCustomModel                         = newCustomModelResource
+	ResourceEvaluationJob                       = newEvaluationJobResource
+	ResourceModelInvocationJob                  = newModelInvocationJobResource
 	ResourceModelInvocationLoggingConfiguration = newModelInvocationLoggingConfigurationResource
 
 	FindCustomModelByID                     = findCustomModelByID
+	FindEvaluationJobByARN                  = findEvaluationJobByARN
 	FindModelCustomizationJobByID           = findModelCustomizationJobByID
+	FindModelInvocationJobByARN             = findModelInvocationJobByARN
 	FindModelInvocationLoggingConfiguration = findModelInvocationLoggingConfiguration
 	FindProvisionedModelThroughputByID      = findProvisionedModelThroughputByID
 	WaitModelCustomizationJobCompleted      = waitModelCustomizationJobCompleted
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccModelInvocationJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_model_invocation_job.test"
+	var v bedrock.GetModelInvocationJobOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelInvocationJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelInvocationJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.s3_input_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.s3_input_data_config.0.s3_input_format", "JSONL"),
+					resource.TestCheckResourceAttrPair(resourceName, "model_id", "data.aws_bedrock_foundation_model.test", "model_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.0.s3_output_data_config.#", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "output_data_config.0.s3_output_data_config.0.s3_encryption_key_id"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrSet(resourceName, "submit_time"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "timeout_duration_in_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrMessage, names.AttrStatus, "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func testAccModelInvocationJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_model_invocation_job.test"
+	var v bedrock.GetModelInvocationJobOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelInvocationJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelInvocationJobExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrock.ResourceModelInvocationJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccModelInvocationJob_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_model_invocation_job.test"
+	var v bedrock.GetModelInvocationJobOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelInvocationJobConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelInvocationJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccModelInvocationJobConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelInvocationJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccModelInvocationJob_vpcConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_model_invocation_job.test"
+	var v bedrock.GetModelInvocationJobOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelInvocationJobConfig_vpcConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelInvocationJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "timeout_duration_in_hours", "48"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrMessage, names.AttrStatus, "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func testAccCheckModelInvocationJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_model_invocation_job" {
+				continue
+			}
+
+			_, err := tfbedrock.FindModelInvocationJobByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Model Invocation Job %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckModelInvocationJobExists(ctx context.Context, n string, v *bedrock.GetModelInvocationJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		output, err := tfbedrock.FindModelInvocationJobByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccModelInvocationJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_region" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+# Batch inference jobs require a minimum number of records.
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "input/records.jsonl"
+  content = join("\n", [for i in range(100) : jsonencode({
+    "recordId" : format("RECORD%%08d", i),
+    "modelInput" : {
+      "inputText" : "Summarize the number ${i} in one sentence.",
+      "textGenerationConfig" : {
+        "maxTokenCount" : 64
+      }
+    }
+  })])
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  # See https://docs.aws.amazon.com/bedrock/latest/userguide/batch-iam-sr.html.
+  assume_role_policy = jsonencode({
+    "Version" : "2012-10-17",
+    "Statement" : [{
+      "Effect" : "Allow",
+      "Principal" : {
+        "Service" : "bedrock.amazonaws.com"
+      },
+      "Action" : "sts:AssumeRole",
+      "Condition" : {
+        "StringEquals" : {
+          "aws:SourceAccount" : data.aws_caller_identity.current.account_id
+        },
+        "ArnEquals" : {
+          "aws:SourceArn" : "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:model-invocation-job/*"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    "Version" : "2012-10-17",
+    "Statement" : [{
+      "Effect" : "Allow",
+      "Action" : [
+        "s3:GetObject",
+        "s3:PutObject",
+        "s3:ListBucket"
+      ],
+      "Resource" : [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }]
+  })
+}
+
+data "aws_bedrock_foundation_model" "test" {
+  model_id = "amazon.titan-text-express-v1"
+}
+`, rName)
+}
+
+func testAccModelInvocationJobConfig_resource(rName, extra string) string {
+	return acctest.ConfigCompose(testAccModelInvocationJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrock_model_invocation_job" "test" {
+  name                = %[1]q
+  model_id            = data.aws_bedrock_foundation_model.test.model_id
+  role_arn            = aws_iam_role.test.arn
+  wait_for_completion = false
+
+  input_data_config {
+    s3_input_data_config {
+      s3_uri = "s3://${aws_s3_bucket.test.id}/${aws_s3_object.test.key}"
+    }
+  }
+
+  output_data_config {
+    s3_output_data_config {
+      s3_uri = "s3://${aws_s3_bucket.test.id}/output/"
+    }
+  }
+
+%[2]s
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, extra))
+}
+
+func testAccModelInvocationJobConfig_basic(rName string) string {
+	return testAccModelInvocationJobConfig_resource(rName, "")
+}
+
+func testAccModelInvocationJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccModelInvocationJobConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccModelInvocationJobConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccModelInvocationJobConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccModelInvocationJobConfig_vpcConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_role_policy" "vpc" {
+  name = "%[1]s-vpc"
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    "Version" : "2012-10-17",
+    "Statement" : [{
+      "Effect" : "Allow",
+      "Action" : [
+        "ec2:DescribeNetworkInterfaces",
+        "ec2:DescribeVpcs",
+        "ec2:DescribeDhcpOptions",
+        "ec2:DescribeSubnets",
+        "ec2:DescribeSecurityGroups",
+        "ec2:CreateNetworkInterface",
+        "ec2:CreateNetworkInterfacePermission",
+        "ec2:DeleteNetworkInterface",
+        "ec2:DeleteNetworkInterfacePermission",
+        "ec2:CreateTags"
+      ],
+      "Resource" : "*"
+    }]
+  })
+}
+`, rName), testAccModelInvocationJobConfig_resource(rName, `
+  timeout_duration_in_hours = 48
+
+  vpc_config {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.test[*].id
+  }
+`))
+}
@@ -26,6 +26,10 @@ func TestAccBedrockAgent_serial(t *testing.T) {
 			"full":               testAccDataSource_full,
 			"update":             testAccDataSource_update,
 		},
+		"IngestionJob": {
+			acctest.CtBasic: testAccIngestionJob_basic,
+			"triggers":      testAccIngestionJob_triggers,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Ingestion Job")
+func newIngestionJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ingestionJobResource{}
+
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+
+	return r, nil
+}
+
+type ingestionJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[ingestionJobResourceModel]
+	framework.WithTimeouts
+}
+
+func (*ingestionJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_ingestion_job"
+}
+
+func (r *ingestionJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data_source_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"failure_reasons": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"ingestion_job_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"knowledge_base_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_failed_documents": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"statistics": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ingestionJobStatisticsModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"number_of_documents_deleted":           types.Int64Type,
+						"number_of_documents_failed":            types.Int64Type,
+						"number_of_documents_scanned":           types.Int64Type,
+						"number_of_metadata_documents_modified": types.Int64Type,
+						"number_of_metadata_documents_scanned":  types.Int64Type,
+						"number_of_modified_documents_indexed":  types.Int64Type,
+						"number_of_new_documents_indexed":       types.Int64Type,
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IngestionJobStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *ingestionJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ingestionJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.StartIngestionJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.StartIngestionJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting Bedrock Agent Ingestion Job (%s)", data.DataSourceID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.IngestionJobID = fwflex.StringToFramework(ctx, output.IngestionJob.IngestionJobId)
+	data.setID()
+
+	job, err := waitIngestionJobComplete(ctx, conn, data.IngestionJobID.ValueString(), data.DataSourceID.ValueString(), data.KnowledgeBaseID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if job != nil {
+		response.Diagnostics.Append(data.flatten(ctx, job)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if err != nil {
+		// Persist the failed job so that the resource is tainted and the job re-run on the next apply.
+		if job != nil {
+			response.Diagnostics.Append(response.State.Set(ctx, data)...)
+		}
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent Ingestion Job (%s) complete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+
+	if err := ingestionJobFailedDocumentsError(job, data.MaxFailedDocuments); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("Bedrock Agent Ingestion Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ingestionJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ingestionJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	job, err := findIngestionJobByThreePartKey(ctx, conn, data.IngestionJobID.ValueString(), data.DataSourceID.ValueString(), data.KnowledgeBaseID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Ingestion Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, job)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ingestionJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ingestionJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Ingestion jobs cannot be deleted. The ingested documents remain in the knowledge base.
+	tflog.Warn(ctx, "Bedrock Agent Ingestion Job removed from state", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+}
+
+func findIngestionJobByThreePartKey(ctx context.Context, conn *bedrockagent.Client, ingestionJobID, dataSourceID, knowledgeBaseID string) (*awstypes.IngestionJob, error) {
+	input := &bedrockagent.GetIngestionJobInput{
+		DataSourceId:    aws.String(dataSourceID),
+		IngestionJobId:  aws.String(ingestionJobID),
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+	}
+
+	output, err := conn.GetIngestionJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IngestionJob == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IngestionJob, nil
+}
+
+func statusIngestionJob(ctx context.Context, conn *bedrockagent.Client, ingestionJobID, dataSourceID, knowledgeBaseID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIngestionJobByThreePartKey(ctx, conn, ingestionJobID, dataSourceID, knowledgeBaseID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIngestionJobComplete(ctx context.Context, conn *bedrockagent.Client, ingestionJobID, dataSourceID, knowledgeBaseID string, timeout time.Duration) (*awstypes.IngestionJob, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.IngestionJobStatusStarting, awstypes.IngestionJobStatusInProgress),
+		Target:       enum.Slice(awstypes.IngestionJobStatusComplete),
+		Refresh:      statusIngestionJob(ctx, conn, ingestionJobID, dataSourceID, knowledgeBaseID),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.IngestionJob); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ingestionJobFailedDocumentsError returns an error if the number of documents that failed
+// to be ingested is above the configured maximum.
+func ingestionJobFailedDocumentsError(job *awstypes.IngestionJob, maxFailedDocuments types.Int64) error {
+	if maxFailedDocuments.IsNull() || maxFailedDocuments.IsUnknown() || job.Statistics == nil {
+		return nil
+	}
+
+	if failed, max := job.Statistics.NumberOfDocumentsFailed, maxFailedDocuments.ValueInt64(); failed > max {
+		return fmt.Errorf("%d documents failed to be ingested, above the maximum of %d", failed, max)
+	}
+
+	return nil
+}
+
+type ingestionJobResourceModel struct {
+	DataSourceID       types.String                                                 `tfsdk:"data_source_id"`
+	Description        types.String                                                 `tfsdk:"description"`
+	FailureReasons     fwtypes.ListValueOf[types.String]                            `tfsdk:"failure_reasons"`
+	ID                 types.String                                                 `tfsdk:"id"`
+	IngestionJobID     types.String                                                 `tfsdk:"ingestion_job_id"`
+	KnowledgeBaseID    types.String                                                 `tfsdk:"knowledge_base_id"`
+	MaxFailedDocuments types.Int64                                                  `tfsdk:"max_failed_documents"`
+	StartedAt          timetypes.RFC3339                                            `tfsdk:"started_at"`
+	Statistics         fwtypes.ListNestedObjectValueOf[ingestionJobStatisticsModel] `tfsdk:"statistics"`
+	Status             fwtypes.StringEnum[awstypes.IngestionJobStatus]              `tfsdk:"status"`
+	Timeouts           timeouts.Value                                               `tfsdk:"timeouts"`
+	Triggers           fwtypes.MapValueOf[types.String]                             `tfsdk:"triggers"`
+	UpdatedAt          timetypes.RFC3339                                            `tfsdk:"updated_at"`
+}
+
+const (
+	ingestionJobResourceIDPartCount = 3
+)
+
+func (m *ingestionJobResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), ingestionJobResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.IngestionJobID = types.StringValue(parts[0])
+	m.DataSourceID = types.StringValue(parts[1])
+	m.KnowledgeBaseID = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (m *ingestionJobResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.IngestionJobID.ValueString(), m.DataSourceID.ValueString(), m.KnowledgeBaseID.ValueString()}, ingestionJobResourceIDPartCount, false)))
+}
+
+func (m *ingestionJobResourceModel) flatten(ctx context.Context, job *awstypes.IngestionJob) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, job, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.StartedAt = fwflex.TimeToFramework(ctx, job.StartedAt)
+	m.UpdatedAt = fwflex.TimeToFramework(ctx, job.UpdatedAt)
+
+	return diags
+}
+
+type ingestionJobStatisticsModel struct {
+	NumberOfDocumentsDeleted          types.Int64 `tfsdk:"number_of_documents_deleted"`
+	NumberOfDocumentsFailed           types.Int64 `tfsdk:"number_of_documents_failed"`
+	NumberOfDocumentsScanned          types.Int64 `tfsdk:"number_of_documents_scanned"`
+	NumberOfMetadataDocumentsModified types.Int64 `tfsdk:"number_of_metadata_documents_modified"`
+	NumberOfMetadataDocumentsScanned  types.Int64 `tfsdk:"number_of_metadata_documents_scanned"`
+	NumberOfModifiedDocumentsIndexed  types.Int64 `tfsdk:"number_of_modified_documents_indexed"`
+	NumberOfNewDocumentsIndexed       types.Int64 `tfsdk:"number_of_new_documents_indexed"`
+}
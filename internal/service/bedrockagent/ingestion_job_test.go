@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Prerequisites:
+// * psql run via null_resource/provisioner "local-exec"
+// * jq for parsing output from aws cli to retrieve postgres password
+func testAccIngestionJob_basic(t *testing.T) {
+	acctest.SkipIfExeNotOnPath(t, "psql")
+	acctest.SkipIfExeNotOnPath(t, "jq")
+	acctest.SkipIfExeNotOnPath(t, "aws")
+
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var ingestionJob types.IngestionJob
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_ingestion_job.test"
+	dataSourceResourceName := "aws_bedrockagent_data_source.test"
+	foundationModel := "amazon.titan-embed-text-v1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "3.2.2",
+			},
+		},
+		CheckDestroy: testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionJobConfig_basic(rName, foundationModel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionJobExists(ctx, resourceName, &ingestionJob),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source_id", dataSourceResourceName, "data_source_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingestion_job_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_id", dataSourceResourceName, "knowledge_base_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_at"),
+					resource.TestCheckResourceAttr(resourceName, "statistics.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "statistics.0.number_of_documents_failed", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "statistics.0.number_of_documents_scanned", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "statistics.0.number_of_new_documents_indexed", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.IngestionJobStatusComplete)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"max_failed_documents", "triggers"},
+			},
+		},
+	})
+}
+
+// Prerequisites:
+// * psql run via null_resource/provisioner "local-exec"
+// * jq for parsing output from aws cli to retrieve postgres password
+func testAccIngestionJob_triggers(t *testing.T) {
+	acctest.SkipIfExeNotOnPath(t, "psql")
+	acctest.SkipIfExeNotOnPath(t, "jq")
+	acctest.SkipIfExeNotOnPath(t, "aws")
+
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 types.IngestionJob
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_ingestion_job.test"
+	foundationModel := "amazon.titan-embed-text-v1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "3.2.2",
+			},
+		},
+		CheckDestroy: testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionJobConfig_triggers(rName, foundationModel, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionJobExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "max_failed_documents", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccIngestionJobConfig_triggers(rName, foundationModel, "two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionJobExists(ctx, resourceName, &v2),
+					testAccCheckIngestionJobRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "statistics.0.number_of_modified_documents_indexed", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIngestionJobExists(ctx context.Context, n string, v *types.IngestionJob) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		output, err := tfbedrockagent.FindIngestionJobByThreePartKey(ctx, conn, rs.Primary.Attributes["ingestion_job_id"], rs.Primary.Attributes["data_source_id"], rs.Primary.Attributes["knowledge_base_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIngestionJobRecreated(i, j *types.IngestionJob) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if i, j := *i.IngestionJobId, *j.IngestionJobId; i == j {
+			return fmt.Errorf("Bedrock Agent Ingestion Job (%s) not recreated", i)
+		}
+
+		return nil
+	}
+}
+
+func testAccIngestionJobConfig_base(rName, embeddingModel, content string) string {
+	return acctest.ConfigCompose(testAccDataSourceConfig_basic(rName, embeddingModel), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "document.txt"
+  content = %[1]q
+}
+`, content))
+}
+
+func testAccIngestionJobConfig_basic(rName, embeddingModel string) string {
+	return acctest.ConfigCompose(testAccIngestionJobConfig_base(rName, embeddingModel, rName), `
+resource "aws_bedrockagent_ingestion_job" "test" {
+  data_source_id    = aws_bedrockagent_data_source.test.data_source_id
+  knowledge_base_id = aws_bedrockagent_data_source.test.knowledge_base_id
+
+  depends_on = [aws_s3_object.test]
+}
+`)
+}
+
+func testAccIngestionJobConfig_triggers(rName, embeddingModel, content string) string {
+	return acctest.ConfigCompose(testAccIngestionJobConfig_base(rName, embeddingModel, content), `
+resource "aws_bedrockagent_ingestion_job" "test" {
+  data_source_id       = aws_bedrockagent_data_source.test.data_source_id
+  knowledge_base_id    = aws_bedrockagent_data_source.test.knowledge_base_id
+  max_failed_documents = 0
+
+  triggers = {
+    document = aws_s3_object.test.etag
+  }
+}
+`)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudtrail_channel", name="Channel")
+// @Tags(identifierAttribute="id")
+func resourceChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelCreate,
+		ReadWithoutTimeout:   resourceChannelRead,
+		UpdateWithoutTimeout: resourceChannelUpdate,
+		DeleteWithoutTimeout: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDestination: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 200,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrLocation: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 1024),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.DestinationType](),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 128),
+			},
+			names.AttrSource: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &cloudtrail.CreateChannelInput{
+		Destinations: expandChannelDestinations(d.Get(names.AttrDestination).(*schema.Set).List()),
+		Name:         aws.String(name),
+		Source:       aws.String(d.Get(names.AttrSource).(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateChannel(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudTrail Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ChannelArn))
+
+	return append(diags, resourceChannelRead(ctx, d, meta)...)
+}
+
+func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	output, err := findChannelByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudTrail Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ChannelArn)
+	if err := d.Set(names.AttrDestination, flattenChannelDestinations(output.Destinations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting destination: %s", err)
+	}
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrSource, output.Source)
+
+	return diags
+}
+
+func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &cloudtrail.UpdateChannelInput{
+			Channel: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDestination) {
+			input.Destinations = expandChannelDestinations(d.Get(names.AttrDestination).(*schema.Set).List())
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		_, err := conn.UpdateChannel(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudTrail Channel (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceChannelRead(ctx, d, meta)...)
+}
+
+func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudTrail Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(ctx, &cloudtrail.DeleteChannelInput{
+		Channel: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ChannelNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudTrail Channel (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findChannelByARN(ctx context.Context, conn *cloudtrail.Client, arn string) (*cloudtrail.GetChannelOutput, error) {
+	input := &cloudtrail.GetChannelInput{
+		Channel: aws.String(arn),
+	}
+
+	output, err := conn.GetChannel(ctx, input)
+
+	if errs.IsA[*types.ChannelNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandChannelDestinations(tfList []interface{}) []types.Destination {
+	apiObjects := make([]types.Destination, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.Destination{
+			Location: aws.String(tfMap[names.AttrLocation].(string)),
+			Type:     types.DestinationType(tfMap[names.AttrType].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenChannelDestinations(apiObjects []types.Destination) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrLocation: aws.ToString(apiObject.Location),
+			names.AttrType:     string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
@@ -55,6 +55,10 @@ func TestAccCloudTrail_serial(t *testing.T) {
 			acctest.CtDisappears:    testAccTrail_disappears,
 			"migrateV0":             testAccTrail_migrateV0,
 		},
+		"OrganizationDelegatedAdminAccount": {
+			acctest.CtBasic:      testAccOrganizationDelegatedAdminAccount_basic,
+			acctest.CtDisappears: testAccOrganizationDelegatedAdminAccount_disappears,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
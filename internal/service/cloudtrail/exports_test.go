@@ -5,9 +5,12 @@ package cloudtrail
 
 // Exports for use in tests only.
 var (
-	ResourceEventDataStore = resourceEventDataStore
-	ResourceTrail          = resourceTrail
+	ResourceChannel                           = resourceChannel
+	ResourceEventDataStore                    = resourceEventDataStore
+	ResourceOrganizationDelegatedAdminAccount = resourceOrganizationDelegatedAdminAccount
+	ResourceTrail                             = resourceTrail
 
+	FindChannelByARN           = findChannelByARN
 	FindEventDataStoreByARN    = findEventDataStoreByARN
 	FindTrailByARN             = findTrailByARN
 	ServiceAccountPerRegionMap = serviceAccountPerRegionMap
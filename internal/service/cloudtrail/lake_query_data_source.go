@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_cloudtrail_lake_query", name="Lake Query")
+func dataSourceLakeQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLakeQueryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bytes_scanned": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"delivery_s3_uri": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 1024),
+				ConflictsWith: []string{"query_id"},
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"query_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"query_id", "query_statement"},
+			},
+			"query_parameters": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      10,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"query_id"},
+			},
+			"query_statement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+				ExactlyOneOf: []string{"query_id", "query_statement"},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"total_results_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLakeQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	// Reuse the results of an existing query if one is specified. Otherwise a new query is run on every read.
+	queryID := d.Get("query_id").(string)
+
+	if queryID == "" {
+		input := &cloudtrail.StartQueryInput{
+			QueryStatement: aws.String(d.Get("query_statement").(string)),
+		}
+
+		if v, ok := d.GetOk("delivery_s3_uri"); ok {
+			input.DeliveryS3Uri = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("query_parameters"); ok && len(v.([]interface{})) > 0 {
+			input.QueryParameters = flex.ExpandStringValueList(v.([]interface{}))
+		}
+
+		output, err := conn.StartQuery(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "starting CloudTrail Lake Query: %s", err)
+		}
+
+		queryID = aws.ToString(output.QueryId)
+	}
+
+	query, err := waitQueryFinished(ctx, conn, queryID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Lake Query (%s) complete: %s", queryID, err)
+	}
+
+	rows, statistics, err := findQueryResultsByID(ctx, conn, queryID, d.Get("max_results").(int))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Lake Query (%s) results: %s", queryID, err)
+	}
+
+	d.SetId(queryID)
+	d.Set("query_id", queryID)
+	d.Set("query_statement", query.QueryString)
+	if err := d.Set("results", flattenQueryResultRows(rows)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+	if statistics != nil {
+		d.Set("bytes_scanned", statistics.BytesScanned)
+		d.Set("total_results_count", statistics.TotalResultsCount)
+	}
+
+	return diags
+}
+
+func findQueryByID(ctx context.Context, conn *cloudtrail.Client, id string) (*cloudtrail.DescribeQueryOutput, error) {
+	input := &cloudtrail.DescribeQueryInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.DescribeQuery(ctx, input)
+
+	if errs.IsA[*types.QueryIdNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findQueryResultsByID returns at most maxResults result rows for the specified query.
+func findQueryResultsByID(ctx context.Context, conn *cloudtrail.Client, id string, maxResults int) ([][]map[string]string, *types.QueryStatistics, error) {
+	input := &cloudtrail.GetQueryResultsInput{
+		QueryId: aws.String(id),
+	}
+	var rows [][]map[string]string
+	var statistics *types.QueryStatistics
+
+	pages := cloudtrail.NewGetQueryResultsPaginator(conn, input)
+	for pages.HasMorePages() && len(rows) < maxResults {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.QueryIdNotFoundException](err) {
+			return nil, nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rows = append(rows, page.QueryResultRows...)
+		statistics = page.QueryStatistics
+	}
+
+	if len(rows) > maxResults {
+		rows = rows[:maxResults]
+	}
+
+	return rows, statistics, nil
+}
+
+func statusQuery(ctx context.Context, conn *cloudtrail.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueryByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.QueryStatus), nil
+	}
+}
+
+func waitQueryFinished(ctx context.Context, conn *cloudtrail.Client, id string, timeout time.Duration) (*cloudtrail.DescribeQueryOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.QueryStatusQueued, types.QueryStatusRunning),
+		Target:       enum.Slice(types.QueryStatusFinished),
+		Refresh:      statusQuery(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.DescribeQueryOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenQueryResultRows converts each result row, returned as a list of single-entry column maps, into a single map.
+func flattenQueryResultRows(apiObjects [][]map[string]string) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := make(map[string]interface{})
+
+		for _, column := range apiObject {
+			for k, v := range column {
+				tfMap[k] = v
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
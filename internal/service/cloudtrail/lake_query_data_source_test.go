@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudTrailLakeQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudtrail_lake_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLakeQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "bytes_scanned"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_results_count"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudTrailLakeQueryDataSource_queryID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudtrail_lake_query.test"
+	reuseDataSourceName := "data.aws_cloudtrail_lake_query.reuse"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLakeQueryDataSourceConfig_queryID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(reuseDataSourceName, "query_id", dataSourceName, "query_id"),
+					resource.TestCheckResourceAttrPair(reuseDataSourceName, "query_statement", dataSourceName, "query_statement"),
+					resource.TestCheckResourceAttrPair(reuseDataSourceName, "results.#", dataSourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLakeQueryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEventDataStoreConfig_basic(rName), `
+data "aws_cloudtrail_lake_query" "test" {
+  query_statement = "SELECT eventID, eventName FROM ${split("/", aws_cloudtrail_event_data_store.test.arn)[1]} LIMIT 10"
+  max_results     = 10
+}
+`)
+}
+
+func testAccLakeQueryDataSourceConfig_queryID(rName string) string {
+	return acctest.ConfigCompose(testAccLakeQueryDataSourceConfig_basic(rName), `
+data "aws_cloudtrail_lake_query" "reuse" {
+  query_id    = data.aws_cloudtrail_lake_query.test.query_id
+  max_results = 10
+}
+`)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudtrail_organization_delegated_admin_account", name="Organization Delegated Admin Account")
+func resourceOrganizationDelegatedAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationDelegatedAdminAccountCreate,
+		ReadWithoutTimeout:   resourceOrganizationDelegatedAdminAccountRead,
+		DeleteWithoutTimeout: resourceOrganizationDelegatedAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrEmail: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_principal": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	cloudTrailServicePrincipal = "cloudtrail.amazonaws.com"
+)
+
+func resourceOrganizationDelegatedAdminAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	accountID := d.Get(names.AttrAccountID).(string)
+	input := &cloudtrail.RegisterOrganizationDelegatedAdminInput{
+		MemberAccountId: aws.String(accountID),
+	}
+
+	_, err := conn.RegisterOrganizationDelegatedAdmin(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "registering CloudTrail Organization Delegated Admin Account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	return append(diags, resourceOrganizationDelegatedAdminAccountRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationDelegatedAdminAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return tforganizations.FindDelegatedAdministratorByTwoPartKey(ctx, conn, d.Id(), cloudTrailServicePrincipal)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudTrail Organization Delegated Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Organization Delegated Admin Account (%s): %s", d.Id(), err)
+	}
+
+	account := outputRaw.(*orgtypes.DelegatedAdministrator)
+	d.Set(names.AttrAccountID, account.Id)
+	d.Set(names.AttrARN, account.Arn)
+	d.Set(names.AttrEmail, account.Email)
+	d.Set(names.AttrName, account.Name)
+	d.Set("service_principal", cloudTrailServicePrincipal)
+
+	return diags
+}
+
+func resourceOrganizationDelegatedAdminAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudTrail Organization Delegated Admin Account: %s", d.Id())
+	_, err := conn.DeregisterOrganizationDelegatedAdmin(ctx, &cloudtrail.DeregisterOrganizationDelegatedAdminInput{
+		DelegatedAdminAccountId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.AccountNotRegisteredException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deregistering CloudTrail Organization Delegated Admin Account (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
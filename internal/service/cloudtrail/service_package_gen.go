@@ -24,6 +24,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceLakeQuery,
+			TypeName: "aws_cloudtrail_lake_query",
+			Name:     "Lake Query",
+		},
 		{
 			Factory:  dataSourceServiceAccount,
 			TypeName: "aws_cloudtrail_service_account",
@@ -42,6 +47,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceChannel,
+			TypeName: "aws_cloudtrail_channel",
+			Name:     "Channel",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory:  resourceEventDataStore,
 			TypeName: "aws_cloudtrail_event_data_store",
@@ -50,6 +63,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceOrganizationDelegatedAdminAccount,
+			TypeName: "aws_cloudtrail_organization_delegated_admin_account",
+			Name:     "Organization Delegated Admin Account",
+		},
 	}
 }
 
@@ -132,6 +132,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceTaskExecution,
+			TypeName: "aws_datasync_task_execution",
+			Name:     "Task Execution",
+		},
 	}
 }
 
@@ -0,0 +1,608 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasync
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_datasync_task_execution", name="Task Execution")
+func resourceTaskExecution() *schema.Resource {
+	// Filters and report configuration not specified for the execution are inherited from the task.
+	excludesSchema := taskFilterRulesSchema()
+	excludesSchema.Computed = true
+	includesSchema := taskFilterRulesSchema()
+	includesSchema.Computed = true
+	reportConfigSchema := taskReportConfigSchema()
+	reportConfigSchema.Computed = true
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTaskExecutionCreate,
+		ReadWithoutTimeout:   resourceTaskExecutionRead,
+		DeleteWithoutTimeout: resourceTaskExecutionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bytes_compressed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bytes_written": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"estimated_bytes_to_transfer": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"estimated_files_to_transfer": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"excludes": forceNewSchema(excludesSchema),
+			"files_deleted": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"files_skipped": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"files_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"files_verified": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"includes": forceNewSchema(includesSchema),
+			"manifest_config": forceNewSchema(&schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.ManifestActionTransfer,
+							ValidateDiagFunc: enum.Validate[awstypes.ManifestAction](),
+						},
+						names.AttrFormat: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.ManifestFormatCsv,
+							ValidateDiagFunc: enum.Validate[awstypes.ManifestFormat](),
+						},
+						names.AttrSource: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_access_role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"manifest_object_path": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"manifest_object_version_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"s3_bucket_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+			"override_options": forceNewSchema(&schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					// Unlike the task's options, unset override options keep the task's value.
+					Schema: map[string]*schema.Schema{
+						"atime": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Atime](),
+						},
+						"bytes_per_second": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(-1),
+						},
+						"gid": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Gid](),
+						},
+						"log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.LogLevel](),
+						},
+						"mtime": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Mtime](),
+						},
+						"object_tags": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ObjectTags](),
+						},
+						"overwrite_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.OverwriteMode](),
+						},
+						"posix_permissions": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PosixPermissions](),
+						},
+						"preserve_deleted_files": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PreserveDeletedFiles](),
+						},
+						"preserve_devices": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PreserveDevices](),
+						},
+						"security_descriptor_copy_flags": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.SmbSecurityDescriptorCopyFlags](),
+						},
+						"task_queueing": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.TaskQueueing](),
+						},
+						"transfer_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.TransferMode](),
+						},
+						"uid": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Uid](),
+						},
+						"verify_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.VerifyMode](),
+						},
+					},
+				},
+			}),
+			"prepare_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"task_report_config": forceNewSchema(reportConfigSchema),
+			"transfer_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"verify_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTaskExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
+
+	taskARN := d.Get("task_arn").(string)
+	input := &datasync.StartTaskExecutionInput{
+		TaskArn: aws.String(taskARN),
+	}
+
+	if v, ok := d.GetOk("excludes"); ok {
+		input.Excludes = expandFilterRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("includes"); ok {
+		input.Includes = expandFilterRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_config"); ok {
+		input.ManifestConfig = expandManifestConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("override_options"); ok {
+		input.OverrideOptions = expandOptions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("task_report_config"); ok {
+		input.TaskReportConfig = expandTaskReportConfig(v.([]interface{}))
+	}
+
+	// Only one execution of a task can run at a time; further executions are queued.
+	output, err := conn.StartTaskExecution(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting DataSync Task (%s) execution: %s", taskARN, err)
+	}
+
+	d.SetId(aws.ToString(output.TaskExecutionArn))
+
+	execution, err := waitTaskExecutionSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DataSync Task Execution (%s) success: %s", d.Id(), err)
+	}
+
+	if v := execution.Result; v != nil && v.VerifyStatus == awstypes.PhaseStatusError {
+		return sdkdiag.AppendErrorf(diags, "DataSync Task Execution (%s) verification failed: %s", d.Id(), taskExecutionResultError(v))
+	}
+
+	return append(diags, resourceTaskExecutionRead(ctx, d, meta)...)
+}
+
+func resourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
+
+	output, err := findTaskExecutionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataSync Task Execution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataSync Task Execution (%s): %s", d.Id(), err)
+	}
+
+	taskARN, err := taskExecutionParseTaskARN(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrARN, output.TaskExecutionArn)
+	d.Set("bytes_compressed", output.BytesCompressed)
+	d.Set("bytes_transferred", output.BytesTransferred)
+	d.Set("bytes_written", output.BytesWritten)
+	d.Set("estimated_bytes_to_transfer", output.EstimatedBytesToTransfer)
+	d.Set("estimated_files_to_transfer", output.EstimatedFilesToTransfer)
+	if err := d.Set("excludes", flattenFilterRules(output.Excludes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting excludes: %s", err)
+	}
+	d.Set("files_deleted", output.FilesDeleted)
+	d.Set("files_skipped", output.FilesSkipped)
+	d.Set("files_transferred", output.FilesTransferred)
+	d.Set("files_verified", output.FilesVerified)
+	if err := d.Set("includes", flattenFilterRules(output.Includes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting includes: %s", err)
+	}
+	if err := d.Set("manifest_config", flattenManifestConfig(output.ManifestConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting manifest_config: %s", err)
+	}
+	if v := output.Result; v != nil {
+		d.Set("prepare_status", v.PrepareStatus)
+		d.Set("transfer_status", v.TransferStatus)
+		d.Set("verify_status", v.VerifyStatus)
+	} else {
+		d.Set("prepare_status", nil)
+		d.Set("transfer_status", nil)
+		d.Set("verify_status", nil)
+	}
+	if v := output.StartTime; v != nil {
+		d.Set("start_time", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("task_arn", taskARN)
+	if err := d.Set("task_report_config", flattenTaskReportConfig(output.TaskReportConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting task_report_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceTaskExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
+
+	// A finished task execution cannot be deleted. Cancel an execution that is still running, e.g. after a timeout.
+	output, err := findTaskExecutionByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataSync Task Execution (%s): %s", d.Id(), err)
+	}
+
+	switch output.Status {
+	case awstypes.TaskExecutionStatusSuccess, awstypes.TaskExecutionStatusError:
+		return diags
+	case awstypes.TaskExecutionStatusCancelling:
+	default:
+		log.Printf("[DEBUG] Cancelling DataSync Task Execution: %s", d.Id())
+		_, err := conn.CancelTaskExecution(ctx, &datasync.CancelTaskExecutionInput{
+			TaskExecutionArn: aws.String(d.Id()),
+		})
+
+		if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "cancelling DataSync Task Execution (%s): %s", d.Id(), err)
+		}
+	}
+
+	if _, err := waitTaskExecutionCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DataSync Task Execution (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// forceNewSchema sets ForceNew on s and on every configurable attribute nested in it.
+// The SDK only plans a replacement for a nested attribute if that attribute itself is ForceNew.
+func forceNewSchema(s *schema.Schema) *schema.Schema {
+	if s.Optional || s.Required {
+		s.ForceNew = true
+	}
+
+	if v, ok := s.Elem.(*schema.Resource); ok {
+		for _, v := range v.Schema {
+			forceNewSchema(v)
+		}
+	}
+
+	return s
+}
+
+// taskExecutionParseTaskARN returns the ARN of the task from a task execution ARN.
+// Task execution ARNs have the form arn:aws:datasync:region:account-id:task/task-id/execution/exec-id.
+func taskExecutionParseTaskARN(arn string) (string, error) {
+	if taskARN, _, found := strings.Cut(arn, "/execution/"); found && taskARN != "" {
+		return taskARN, nil
+	}
+
+	return "", fmt.Errorf("unexpected format for DataSync Task Execution ARN (%s)", arn)
+}
+
+func findTaskExecutionByARN(ctx context.Context, conn *datasync.Client, arn string) (*datasync.DescribeTaskExecutionOutput, error) {
+	input := &datasync.DescribeTaskExecutionInput{
+		TaskExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTaskExecution(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTaskExecution(ctx context.Context, conn *datasync.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTaskExecutionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTaskExecutionSucceeded(ctx context.Context, conn *datasync.Client, arn string, timeout time.Duration) (*datasync.DescribeTaskExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.TaskExecutionStatusQueued,
+			awstypes.TaskExecutionStatusLaunching,
+			awstypes.TaskExecutionStatusPreparing,
+			awstypes.TaskExecutionStatusTransferring,
+			awstypes.TaskExecutionStatusVerifying,
+		),
+		Target:     enum.Slice(awstypes.TaskExecutionStatusSuccess),
+		Refresh:    statusTaskExecution(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datasync.DescribeTaskExecutionOutput); ok {
+		if v := output.Result; v != nil {
+			tfresource.SetLastError(err, taskExecutionResultError(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTaskExecutionCancelled(ctx context.Context, conn *datasync.Client, arn string, timeout time.Duration) (*datasync.DescribeTaskExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.TaskExecutionStatusQueued,
+			awstypes.TaskExecutionStatusCancelling,
+			awstypes.TaskExecutionStatusLaunching,
+			awstypes.TaskExecutionStatusPreparing,
+			awstypes.TaskExecutionStatusTransferring,
+			awstypes.TaskExecutionStatusVerifying,
+		),
+		Target:  enum.Slice(awstypes.TaskExecutionStatusError, awstypes.TaskExecutionStatusSuccess),
+		Refresh: statusTaskExecution(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datasync.DescribeTaskExecutionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func taskExecutionResultError(apiObject *awstypes.TaskExecutionResultDetail) error {
+	if errorCode, errorDetail := aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorDetail); errorCode != "" || errorDetail != "" {
+		return fmt.Errorf("%s: %s", errorCode, errorDetail)
+	}
+
+	return fmt.Errorf("prepare %s, transfer %s, verify %s", apiObject.PrepareStatus, apiObject.TransferStatus, apiObject.VerifyStatus)
+}
+
+func expandManifestConfig(tfList []interface{}) *awstypes.ManifestConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.ManifestConfig{
+		Action: awstypes.ManifestAction(tfMap[names.AttrAction].(string)),
+		Format: awstypes.ManifestFormat(tfMap[names.AttrFormat].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrSource].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			s3 := &awstypes.S3ManifestConfig{
+				BucketAccessRoleArn: aws.String(tfMap["bucket_access_role_arn"].(string)),
+				ManifestObjectPath:  aws.String(tfMap["manifest_object_path"].(string)),
+				S3BucketArn:         aws.String(tfMap["s3_bucket_arn"].(string)),
+			}
+
+			if v, ok := tfMap["manifest_object_version_id"].(string); ok && v != "" {
+				s3.ManifestObjectVersionId = aws.String(v)
+			}
+
+			apiObject.Source = &awstypes.SourceManifestConfig{
+				S3: s3,
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenManifestConfig(apiObject *awstypes.ManifestConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrAction: apiObject.Action,
+		names.AttrFormat: apiObject.Format,
+	}
+
+	if v := apiObject.Source; v != nil && v.S3 != nil {
+		tfMap[names.AttrSource] = []interface{}{map[string]interface{}{
+			"s3": []interface{}{map[string]interface{}{
+				"bucket_access_role_arn":     aws.ToString(v.S3.BucketAccessRoleArn),
+				"manifest_object_path":       aws.ToString(v.S3.ManifestObjectPath),
+				"manifest_object_version_id": aws.ToString(v.S3.ManifestObjectVersionId),
+				"s3_bucket_arn":              aws.ToString(v.S3.S3BucketArn),
+			}},
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasync_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatasync "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataSyncTaskExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datasync.DescribeTaskExecutionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datasync_task_execution.test"
+	taskResourceName := "aws_datasync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskExecutionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "datasync", regexache.MustCompile(`task/task-.+/execution/exec-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "files_transferred", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "prepare_status", string(awstypes.PhaseStatusSuccess)),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.TaskExecutionStatusSuccess)),
+					resource.TestCheckResourceAttrPair(resourceName, "task_arn", taskResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "transfer_status", string(awstypes.PhaseStatusSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "verify_status", string(awstypes.PhaseStatusSuccess)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataSyncTaskExecution_overrides(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datasync.DescribeTaskExecutionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datasync_task_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionConfig_overrides(rName, "/include*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskExecutionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "includes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "includes.0.filter_type", "SIMPLE_PATTERN"),
+					resource.TestCheckResourceAttr(resourceName, "includes.0.value", "/include*"),
+					resource.TestCheckResourceAttr(resourceName, "files_transferred", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "override_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "override_options.0.verify_mode", "ONLY_FILES_TRANSFERRED"),
+					resource.TestCheckResourceAttr(resourceName, "verify_status", string(awstypes.PhaseStatusSuccess)),
+				),
+			},
+			{
+				Config: testAccTaskExecutionConfig_overrides(rName, "/include.txt"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskExecutionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "includes.0.value", "/include.txt"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.TaskExecutionStatusSuccess)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTaskExecutionExists(ctx context.Context, n string, v *datasync.DescribeTaskExecutionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncClient(ctx)
+
+		output, err := tfdatasync.FindTaskExecutionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTaskExecutionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccLocationS3Config_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "include" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "source/include.txt"
+  content = %[1]q
+}
+
+resource "aws_s3_object" "exclude" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "source/exclude.txt"
+  content = %[1]q
+}
+
+resource "aws_datasync_location_s3" "source" {
+  s3_bucket_arn = aws_s3_bucket.test.arn
+  subdirectory  = "/source"
+
+  s3_config {
+    bucket_access_role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_datasync_location_s3" "destination" {
+  s3_bucket_arn = aws_s3_bucket.test.arn
+  subdirectory  = "/destination"
+
+  s3_config {
+    bucket_access_role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_s3.destination.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_s3.source.arn
+
+  excludes {
+    filter_type = "SIMPLE_PATTERN"
+    value       = "/exclude*"
+  }
+}
+`, rName))
+}
+
+func testAccTaskExecutionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTaskExecutionConfig_base(rName), `
+resource "aws_datasync_task_execution" "test" {
+  task_arn = aws_datasync_task.test.arn
+
+  depends_on = [aws_s3_object.include, aws_s3_object.exclude]
+}
+`)
+}
+
+func testAccTaskExecutionConfig_overrides(rName, include string) string {
+	return acctest.ConfigCompose(testAccTaskExecutionConfig_base(rName), fmt.Sprintf(`
+resource "aws_datasync_task_execution" "test" {
+  task_arn = aws_datasync_task.test.arn
+
+  includes {
+    filter_type = "SIMPLE_PATTERN"
+    value       = %[1]q
+  }
+
+  override_options {
+    verify_mode = "ONLY_FILES_TRANSFERRED"
+  }
+
+  depends_on = [aws_s3_object.include, aws_s3_object.exclude]
+}
+`, include))
+}
@@ -30,6 +30,20 @@ const (
 	replicationTaskStatusStopping  = "stopping"
 	replicationTaskStatusRunning   = "running"
 	replicationTaskStatusStarting  = "starting"
+
+	replicationTaskAssessmentRunStatusCancelling        = "cancelling"
+	replicationTaskAssessmentRunStatusDeleting          = "deleting"
+	replicationTaskAssessmentRunStatusErrorExecuting    = "error-executing"
+	replicationTaskAssessmentRunStatusErrorProvisioning = "error-provisioning"
+	replicationTaskAssessmentRunStatusFailed            = "failed"
+	replicationTaskAssessmentRunStatusPassed            = "passed"
+	replicationTaskAssessmentRunStatusProvisioning      = "provisioning"
+	replicationTaskAssessmentRunStatusRunning           = "running"
+	replicationTaskAssessmentRunStatusStarting          = "starting"
+	replicationTaskAssessmentRunStatusWarning           = "warning"
+
+	replicationTaskIndividualAssessmentStatusError  = "error"
+	replicationTaskIndividualAssessmentStatusFailed = "failed"
 )
 
 const (
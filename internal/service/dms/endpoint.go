@@ -1780,7 +1780,7 @@ func startEndpointReplicationTasks(ctx context.Context, conn *dms.Client, arn st
 			return fmt.Errorf("waiting until test connection succeeds: %w", err)
 		}
 
-		if err := startReplicationTask(ctx, conn, aws.ToString(task.ReplicationTaskIdentifier)); err != nil {
+		if err := startReplicationTask(ctx, conn, aws.ToString(task.ReplicationTaskIdentifier), ""); err != nil {
 			return fmt.Errorf("starting replication task: %w", err)
 		}
 	}
@@ -110,6 +110,12 @@ func resourceReplicationConfig() *schema.Resource {
 					},
 				},
 			},
+			"premigration_assessment_settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
 			"replication_config_identifier": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -213,7 +219,7 @@ func resourceReplicationConfigCreate(ctx context.Context, d *schema.ResourceData
 	d.SetId(aws.ToString(output.ReplicationConfig.ReplicationConfigArn))
 
 	if d.Get("start_replication").(bool) {
-		if err := startReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if err := startReplication(ctx, conn, d.Id(), d.Get("premigration_assessment_settings").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -256,7 +262,8 @@ func resourceReplicationConfigUpdate(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DMSClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_replication") {
+	// Premigration assessment settings are only used when the replication is started, so changing them does not stop it.
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "premigration_assessment_settings", "start_replication") {
 		if err := stopReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -302,20 +309,20 @@ func resourceReplicationConfigUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if d.Get("start_replication").(bool) {
-			if err := startReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if err := startReplication(ctx, conn, d.Id(), d.Get("premigration_assessment_settings").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
 	}
 
 	if d.HasChange("start_replication") {
-		var f func(context.Context, *dms.Client, string, time.Duration) error
+		var err error
 		if d.Get("start_replication").(bool) {
-			f = startReplication
+			err = startReplication(ctx, conn, d.Id(), d.Get("premigration_assessment_settings").(string), d.Timeout(schema.TimeoutUpdate))
 		} else {
-			f = stopReplication
+			err = stopReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		}
-		if err := f(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -459,6 +466,37 @@ func statusReplication(ctx context.Context, conn *dms.Client, arn string) retry.
 	}
 }
 
+// statusReplicationPremigrationAssessment returns the status of the most recent premigration assessment run,
+// ignoring the run with ARN previousRunARN that existed before the replication was started.
+func statusReplicationPremigrationAssessment(ctx context.Context, conn *dms.Client, arn, previousRunARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReplicationByReplicationConfigARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		assessment := latestPremigrationAssessmentStatus(output)
+
+		if assessment == nil || aws.ToString(assessment.PremigrationAssessmentRunArn) == previousRunARN {
+			if aws.ToString(output.Status) == replicationStatusFailed {
+				err := errors.New("replication failed before the premigration assessment started")
+				setLastReplicationError(err, output)
+				return nil, "", err
+			}
+
+			// The assessment run has not been created yet.
+			return &awstypes.PremigrationAssessmentStatus{}, replicationTaskAssessmentRunStatusStarting, nil
+		}
+
+		return assessment, aws.ToString(assessment.Status), nil
+	}
+}
+
 func setLastReplicationError(err error, replication *awstypes.Replication) {
 	var errs []error
 
@@ -501,6 +539,38 @@ func waitReplicationRunning(ctx context.Context, conn *dms.Client, arn string, t
 	return nil, err
 }
 
+func waitReplicationPremigrationAssessmentCompleted(ctx context.Context, conn *dms.Client, arn, previousRunARN string, timeout time.Duration) (*awstypes.PremigrationAssessmentStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationTaskAssessmentRunStatusCancelling,
+			replicationTaskAssessmentRunStatusProvisioning,
+			replicationTaskAssessmentRunStatusRunning,
+			replicationTaskAssessmentRunStatusStarting,
+		},
+		Target: []string{
+			replicationTaskAssessmentRunStatusFailed,
+			replicationTaskAssessmentRunStatusPassed,
+			replicationTaskAssessmentRunStatusWarning,
+		},
+		Refresh:    statusReplicationPremigrationAssessment(ctx, conn, arn, previousRunARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.PremigrationAssessmentStatus); ok {
+		if v := aws.ToString(output.LastFailureMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitReplicationStopped(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.Replication, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{replicationStatusStopping, replicationStatusRunning},
@@ -541,7 +611,9 @@ func waitReplicationDeleted(ctx context.Context, conn *dms.Client, arn string, t
 	return nil, err
 }
 
-func startReplication(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) error {
+// startReplication starts or resumes the replication.
+// Premigration assessment settings are only used when the replication is started for the first time.
+func startReplication(ctx context.Context, conn *dms.Client, arn, premigrationAssessmentSettings string, timeout time.Duration) error {
 	replication, err := findReplicationByReplicationConfigARN(ctx, conn, arn)
 
 	if err != nil {
@@ -562,12 +634,34 @@ func startReplication(ctx context.Context, conn *dms.Client, arn string, timeout
 		StartReplicationType: aws.String(startReplicationType),
 	}
 
+	var previousRunARN string
+	assess := premigrationAssessmentSettings != "" && startReplicationType == replicationTypeValueStartReplication
+	if assess {
+		input.PremigrationAssessmentSettings = aws.String(premigrationAssessmentSettings)
+
+		if v := latestPremigrationAssessmentStatus(replication); v != nil {
+			previousRunARN = aws.ToString(v.PremigrationAssessmentRunArn)
+		}
+	}
+
 	_, err = conn.StartReplication(ctx, input)
 
 	if err != nil {
 		return fmt.Errorf("starting DMS Serverless Replication (%s): %w", arn, err)
 	}
 
+	if assess {
+		assessment, err := waitReplicationPremigrationAssessmentCompleted(ctx, conn, arn, previousRunARN, timeout)
+
+		if err != nil {
+			return fmt.Errorf("waiting for DMS Serverless Replication (%s) premigration assessment: %w", arn, err)
+		}
+
+		if aws.ToString(assessment.Status) == replicationTaskAssessmentRunStatusFailed && assessment.FailOnAssessmentFailure {
+			return fmt.Errorf("DMS Serverless Replication (%s) premigration assessment (%s) failed: %s", arn, aws.ToString(assessment.PremigrationAssessmentRunArn), aws.ToString(assessment.LastFailureMessage))
+		}
+	}
+
 	if _, err := waitReplicationRunning(ctx, conn, arn, timeout); err != nil {
 		return fmt.Errorf("waiting for DMS Serverless Replication (%s) start: %w", arn, err)
 	}
@@ -607,6 +701,18 @@ func stopReplication(ctx context.Context, conn *dms.Client, arn string, timeout
 	return nil
 }
 
+func latestPremigrationAssessmentStatus(replication *awstypes.Replication) *awstypes.PremigrationAssessmentStatus {
+	var latest *awstypes.PremigrationAssessmentStatus
+
+	for i, v := range replication.PremigrationAssessmentStatuses {
+		if latest == nil || aws.ToTime(v.PremigrationAssessmentRunCreationDate).After(aws.ToTime(latest.PremigrationAssessmentRunCreationDate)) {
+			latest = &replication.PremigrationAssessmentStatuses[i]
+		}
+	}
+
+	return latest
+}
+
 func flattenComputeConfig(apiObject *awstypes.ComputeConfig) []interface{} {
 	if apiObject == nil {
 		return nil
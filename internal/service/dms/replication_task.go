@@ -60,6 +60,43 @@ func resourceReplicationTask() *schema.Resource {
 				Required:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.MigrationTypeValue](),
 			},
+			"reload_tables": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reload_option": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(awstypes.ReloadOptionValueDataReload),
+							ValidateDiagFunc: enum.Validate[awstypes.ReloadOptionValue](),
+						},
+						"table": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schema_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrTableName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						names.AttrTriggers: {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"replication_instance_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -110,6 +147,11 @@ func resourceReplicationTask() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"start_replication_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.StartReplicationTaskTypeValue](),
+			},
 			names.AttrStatus: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -183,7 +225,7 @@ func resourceReplicationTaskCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.Get("start_replication_task").(bool) {
-		if err := startReplicationTask(ctx, conn, d.Id()); err != nil {
+		if err := startReplicationTask(ctx, conn, d.Id(), d.Get("start_replication_type").(string)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -225,7 +267,14 @@ func resourceReplicationTaskUpdate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DMSClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "replication_instance_arn", "start_replication_task") {
+	// Only use the configured start type when start_replication_task or start_replication_type has just been changed,
+	// so that e.g. reload-target doesn't fully reload the target every time the task is restarted after a modification.
+	var startType string
+	if d.HasChanges("start_replication_task", "start_replication_type") {
+		startType = d.Get("start_replication_type").(string)
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "reload_tables", "replication_instance_arn", "start_replication_task", "start_replication_type") {
 		if err := stopReplicationTask(ctx, conn, d.Id()); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -272,7 +321,7 @@ func resourceReplicationTaskUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if d.Get("start_replication_task").(bool) {
-			if err := startReplicationTask(ctx, conn, d.Id()); err != nil {
+			if err := startReplicationTask(ctx, conn, d.Id(), startType); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
@@ -299,24 +348,34 @@ func resourceReplicationTaskUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if d.Get("start_replication_task").(bool) {
-			if err := startReplicationTask(ctx, conn, d.Id()); err != nil {
+			if err := startReplicationTask(ctx, conn, d.Id(), startType); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
 	}
 
-	if d.HasChanges("start_replication_task") {
-		var f func(context.Context, *dms.Client, string) error
+	// A task that is already running is not restarted when only start_replication_type changes.
+	if d.HasChanges("start_replication_task", "start_replication_type") {
+		var err error
 		if d.Get("start_replication_task").(bool) {
-			f = startReplicationTask
-		} else {
-			f = stopReplicationTask
+			err = startReplicationTask(ctx, conn, d.Id(), startType)
+		} else if d.HasChange("start_replication_task") {
+			err = stopReplicationTask(ctx, conn, d.Id())
 		}
-		if err := f(ctx, conn, d.Id()); err != nil {
+		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
+	// Tables are only reloaded on update; a newly created task loads all of its tables anyway.
+	if d.HasChange("reload_tables") {
+		if v, ok := d.GetOk("reload_tables"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := reloadReplicationTaskTables(ctx, conn, d.Get("replication_task_arn").(string), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendErrorf(diags, "reloading DMS Replication Task (%s) tables: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceReplicationTaskRead(ctx, d, meta)...)
 }
 
@@ -575,7 +634,9 @@ func waitReplicationTaskSteady(ctx context.Context, conn *dms.Client, id string)
 	return nil, err
 }
 
-func startReplicationTask(ctx context.Context, conn *dms.Client, id string) error {
+// startReplicationTask starts the specified replication task if it is not already running.
+// A task that has never run is always started with start-replication; otherwise startType is used, defaulting to resume-processing.
+func startReplicationTask(ctx context.Context, conn *dms.Client, id string, startType string) error {
 	task, err := findReplicationTaskByID(ctx, conn, id)
 
 	if err != nil {
@@ -590,6 +651,9 @@ func startReplicationTask(ctx context.Context, conn *dms.Client, id string) erro
 	startReplicationTaskType := awstypes.StartReplicationTaskTypeValueStartReplication
 	if taskStatus != replicationTaskStatusReady {
 		startReplicationTaskType = awstypes.StartReplicationTaskTypeValueResumeProcessing
+		if startType != "" {
+			startReplicationTaskType = awstypes.StartReplicationTaskTypeValue(startType)
+		}
 	}
 	input := &dms.StartReplicationTaskInput{
 		ReplicationTaskArn:       task.ReplicationTaskArn,
@@ -645,3 +709,34 @@ func stopReplicationTask(ctx context.Context, conn *dms.Client, id string) error
 
 	return nil
 }
+
+func reloadReplicationTaskTables(ctx context.Context, conn *dms.Client, arn string, tfMap map[string]interface{}) error {
+	input := &dms.ReloadTablesInput{
+		ReloadOption:       awstypes.ReloadOptionValue(tfMap["reload_option"].(string)),
+		ReplicationTaskArn: aws.String(arn),
+		TablesToReload:     expandTablesToReload(tfMap["table"].(*schema.Set).List()),
+	}
+
+	_, err := conn.ReloadTables(ctx, input)
+
+	return err
+}
+
+func expandTablesToReload(tfList []interface{}) []awstypes.TableToReload {
+	var apiObjects []awstypes.TableToReload
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.TableToReload{
+			SchemaName: aws.String(tfMap["schema_name"].(string)),
+			TableName:  aws.String(tfMap[names.AttrTableName].(string)),
+		})
+	}
+
+	return apiObjects
+}
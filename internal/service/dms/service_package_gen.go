@@ -110,6 +110,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "replication_task_arn",
 			},
 		},
+		{
+			Factory:  resourceReplicationTaskAssessmentRun,
+			TypeName: "aws_dms_replication_task_assessment_run",
+			Name:     "Replication Task Assessment Run",
+		},
 		{
 			Factory:  resourceS3Endpoint,
 			TypeName: "aws_dms_s3_endpoint",
@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecr_image_scan_findings", name="Image Scan Findings")
+func dataSourceImageScanFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImageScanFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"enhanced_findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remediation_recommendation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSource: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerability_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerable_packages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arch": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"epoch": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"file_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"package_manager": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"release": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrVersion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"finding_severity_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrURI: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"image_digest", "image_tag"},
+			},
+			"image_scan_completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_scan_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_scan_status_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"image_digest", "image_tag"},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrRepositoryName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vulnerability_source_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceImageScanFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	repositoryName := d.Get(names.AttrRepositoryName).(string)
+	input := &ecr.DescribeImageScanFindingsInput{
+		ImageId:        &types.ImageIdentifier{},
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk("image_digest"); ok {
+		input.ImageId.ImageDigest = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_tag"); ok {
+		input.ImageId.ImageTag = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	output, err := findImageScanFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("ECR Image Scan Findings", err))
+	}
+
+	imageDigest := aws.ToString(output.ImageId.ImageDigest)
+	d.SetId(fmt.Sprintf("%s@%s", repositoryName, imageDigest))
+	if err := d.Set("enhanced_findings", flattenEnhancedImageScanFindings(output.ImageScanFindings.EnhancedFindings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting enhanced_findings: %s", err)
+	}
+	d.Set("finding_severity_counts", output.ImageScanFindings.FindingSeverityCounts)
+	if err := d.Set("findings", flattenImageScanFindings(output.ImageScanFindings.Findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set("image_digest", imageDigest)
+	if v := output.ImageScanFindings.ImageScanCompletedAt; v != nil {
+		d.Set("image_scan_completed_at", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("image_scan_completed_at", nil)
+	}
+	if v := output.ImageScanStatus; v != nil {
+		d.Set("image_scan_status", v.Status)
+		d.Set("image_scan_status_description", v.Description)
+	} else {
+		d.Set("image_scan_status", nil)
+		d.Set("image_scan_status_description", nil)
+	}
+	d.Set("registry_id", output.RegistryId)
+	d.Set(names.AttrRepositoryName, output.RepositoryName)
+	if v := output.ImageScanFindings.VulnerabilitySourceUpdatedAt; v != nil {
+		d.Set("vulnerability_source_updated_at", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("vulnerability_source_updated_at", nil)
+	}
+
+	return diags
+}
+
+// findImageScanFindings returns the first page of output with the findings of all pages.
+func findImageScanFindings(ctx context.Context, conn *ecr.Client, input *ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error) {
+	var output *ecr.DescribeImageScanFindingsOutput
+
+	pages := ecr.NewDescribeImageScanFindingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ImageNotFoundException](err) || errs.IsA[*types.RepositoryNotFoundException](err) || errs.IsA[*types.ScanNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			output = page
+			if output.ImageScanFindings == nil {
+				output.ImageScanFindings = &types.ImageScanFindings{}
+			}
+			continue
+		}
+
+		if page.ImageScanFindings != nil {
+			output.ImageScanFindings.EnhancedFindings = append(output.ImageScanFindings.EnhancedFindings, page.ImageScanFindings.EnhancedFindings...)
+			output.ImageScanFindings.Findings = append(output.ImageScanFindings.Findings, page.ImageScanFindings.Findings...)
+		}
+	}
+
+	if output == nil || output.ImageId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenImageScanFindings(apiObjects []types.ImageScanFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		attributes := make(map[string]interface{}, len(apiObject.Attributes))
+		for _, v := range apiObject.Attributes {
+			attributes[aws.ToString(v.Key)] = aws.ToString(v.Value)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attributes":          attributes,
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			names.AttrName:        aws.ToString(apiObject.Name),
+			"severity":            apiObject.Severity,
+			names.AttrURI:         aws.ToString(apiObject.Uri),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEnhancedImageScanFindings(apiObjects []types.EnhancedImageScanFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"finding_arn":         aws.ToString(apiObject.FindingArn),
+			"score":               apiObject.Score,
+			"severity":            aws.ToString(apiObject.Severity),
+			names.AttrStatus:      aws.ToString(apiObject.Status),
+			"title":               aws.ToString(apiObject.Title),
+			names.AttrType:        aws.ToString(apiObject.Type),
+		}
+
+		if v := apiObject.FirstObservedAt; v != nil {
+			tfMap["first_observed_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastObservedAt; v != nil {
+			tfMap["last_observed_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.Remediation; v != nil && v.Recommendation != nil {
+			tfMap["remediation_recommendation"] = aws.ToString(v.Recommendation.Text)
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.PackageVulnerabilityDetails; v != nil {
+			tfMap[names.AttrSource] = aws.ToString(v.Source)
+			tfMap["source_url"] = aws.ToString(v.SourceUrl)
+			tfMap["vulnerability_id"] = aws.ToString(v.VulnerabilityId)
+			tfMap["vulnerable_packages"] = flattenVulnerablePackages(v.VulnerablePackages)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenVulnerablePackages(apiObjects []types.VulnerablePackage) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arch":            aws.ToString(apiObject.Arch),
+			"epoch":           aws.ToInt32(apiObject.Epoch),
+			"file_path":       aws.ToString(apiObject.FilePath),
+			names.AttrName:    aws.ToString(apiObject.Name),
+			"package_manager": aws.ToString(apiObject.PackageManager),
+			"release":         aws.ToString(apiObject.Release),
+			names.AttrVersion: aws.ToString(apiObject.Version),
+		})
+	}
+
+	return tfList
+}
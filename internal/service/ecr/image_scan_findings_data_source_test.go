@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImageScanFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// The repository must contain an image with the specified tag that has been scanned.
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "ECR_IMAGE_SCAN_FINDINGS_REPOSITORY_NAME")
+	imageTag := acctest.SkipIfEnvVarNotSet(t, "ECR_IMAGE_SCAN_FINDINGS_IMAGE_TAG")
+	dataSourceByTag := "data.aws_ecr_image_scan_findings.by_tag"
+	dataSourceByDigest := "data.aws_ecr_image_scan_findings.by_digest"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageScanFindingsDataSourceConfig_basic(repositoryName, imageTag),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceByTag, "image_digest"),
+					resource.TestCheckResourceAttr(dataSourceByTag, "image_scan_status", "COMPLETE"),
+					resource.TestCheckResourceAttrSet(dataSourceByTag, "image_scan_completed_at"),
+					acctest.CheckResourceAttrAccountID(dataSourceByTag, "registry_id"),
+					resource.TestCheckResourceAttr(dataSourceByTag, names.AttrRepositoryName, repositoryName),
+					resource.TestCheckResourceAttrPair(dataSourceByDigest, "image_scan_completed_at", dataSourceByTag, "image_scan_completed_at"),
+					resource.TestCheckResourceAttrPair(dataSourceByDigest, "findings.#", dataSourceByTag, "findings.#"),
+					resource.TestCheckResourceAttrPair(dataSourceByDigest, "enhanced_findings.#", dataSourceByTag, "enhanced_findings.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImageScanFindingsDataSource_notFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "ECR_IMAGE_SCAN_FINDINGS_REPOSITORY_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccImageScanFindingsDataSourceConfig_notFound(repositoryName),
+				ExpectError: regexache.MustCompile(`no matching ECR Image Scan Findings found`),
+			},
+		},
+	})
+}
+
+func testAccImageScanFindingsDataSourceConfig_basic(repositoryName, imageTag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image_scan_findings" "by_tag" {
+  repository_name = %[1]q
+  image_tag       = %[2]q
+}
+
+data "aws_ecr_image_scan_findings" "by_digest" {
+  repository_name = data.aws_ecr_image_scan_findings.by_tag.repository_name
+  image_digest    = data.aws_ecr_image_scan_findings.by_tag.image_digest
+}
+`, repositoryName, imageTag)
+}
+
+func testAccImageScanFindingsDataSourceConfig_notFound(repositoryName string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image_scan_findings" "test" {
+  repository_name = %[1]q
+  image_tag       = "tf-acc-test-does-not-exist"
+}
+`, repositoryName)
+}
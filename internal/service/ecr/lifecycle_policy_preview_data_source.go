@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecr_lifecycle_policy_preview", name="Lifecycle Policy Preview")
+func dataSourceLifecyclePolicyPreview() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLifecyclePolicyPreviewRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"expiring_image_total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrPolicy: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"preview_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"applied_rule_priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_pushed_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrRepositoryName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceLifecyclePolicyPreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	repositoryName := d.Get(names.AttrRepositoryName).(string)
+	input := &ecr.StartLifecyclePolicyPreviewInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk(names.AttrPolicy); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.LifecyclePolicyText = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	// Only one preview can run per repository at a time.
+	_, err := tfresource.RetryWhenIsA[*types.LifecyclePolicyPreviewInProgressException](ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
+		return conn.StartLifecyclePolicyPreview(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting ECR Lifecycle Policy Preview (%s): %s", repositoryName, err)
+	}
+
+	if _, err := waitLifecyclePolicyPreviewComplete(ctx, conn, repositoryName, aws.ToString(input.RegistryId), d.Timeout(schema.TimeoutRead)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ECR Lifecycle Policy Preview (%s): %s", repositoryName, err)
+	}
+
+	output, results, err := findLifecyclePolicyPreviewResults(ctx, conn, &ecr.GetLifecyclePolicyPreviewInput{
+		RegistryId:     input.RegistryId,
+		RepositoryName: aws.String(repositoryName),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Lifecycle Policy Preview (%s): %s", repositoryName, err)
+	}
+
+	d.SetId(repositoryName)
+	if v := output.Summary; v != nil {
+		d.Set("expiring_image_total_count", v.ExpiringImageTotalCount)
+	} else {
+		d.Set("expiring_image_total_count", 0)
+	}
+	d.Set(names.AttrPolicy, output.LifecyclePolicyText)
+	if err := d.Set("preview_results", flattenLifecyclePolicyPreviewResults(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting preview_results: %s", err)
+	}
+	d.Set("registry_id", output.RegistryId)
+	d.Set(names.AttrRepositoryName, output.RepositoryName)
+
+	return diags
+}
+
+func findLifecyclePolicyPreview(ctx context.Context, conn *ecr.Client, input *ecr.GetLifecyclePolicyPreviewInput) (*ecr.GetLifecyclePolicyPreviewOutput, error) {
+	output, err := conn.GetLifecyclePolicyPreview(ctx, input)
+
+	if errs.IsA[*types.LifecyclePolicyPreviewNotFoundException](err) || errs.IsA[*types.RepositoryNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findLifecyclePolicyPreviewResults returns the first page of output with the results of all pages.
+func findLifecyclePolicyPreviewResults(ctx context.Context, conn *ecr.Client, input *ecr.GetLifecyclePolicyPreviewInput) (*ecr.GetLifecyclePolicyPreviewOutput, []types.LifecyclePolicyPreviewResult, error) {
+	var output *ecr.GetLifecyclePolicyPreviewOutput
+	var results []types.LifecyclePolicyPreviewResult
+
+	pages := ecr.NewGetLifecyclePolicyPreviewPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.LifecyclePolicyPreviewNotFoundException](err) || errs.IsA[*types.RepositoryNotFoundException](err) {
+			return nil, nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if output == nil {
+			output = page
+		}
+
+		results = append(results, page.PreviewResults...)
+	}
+
+	if output == nil {
+		return nil, nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, results, nil
+}
+
+func statusLifecyclePolicyPreview(ctx context.Context, conn *ecr.Client, repositoryName, registryID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecr.GetLifecyclePolicyPreviewInput{
+			RepositoryName: aws.String(repositoryName),
+		}
+		if registryID != "" {
+			input.RegistryId = aws.String(registryID)
+		}
+
+		output, err := findLifecyclePolicyPreview(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitLifecyclePolicyPreviewComplete(ctx context.Context, conn *ecr.Client, repositoryName, registryID string, timeout time.Duration) (*ecr.GetLifecyclePolicyPreviewOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.LifecyclePolicyPreviewStatusInProgress),
+		Target:  enum.Slice(types.LifecyclePolicyPreviewStatusComplete),
+		Refresh: statusLifecyclePolicyPreview(ctx, conn, repositoryName, registryID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ecr.GetLifecyclePolicyPreviewOutput); ok {
+		if output.Status == types.LifecyclePolicyPreviewStatusFailed || output.Status == types.LifecyclePolicyPreviewStatusExpired {
+			tfresource.SetLastError(err, errors.New(string(output.Status)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenLifecyclePolicyPreviewResults(apiObjects []types.LifecyclePolicyPreviewResult) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"applied_rule_priority": aws.ToInt32(apiObject.AppliedRulePriority),
+			"image_digest":          aws.ToString(apiObject.ImageDigest),
+			"image_tags":            apiObject.ImageTags,
+		}
+
+		if v := apiObject.Action; v != nil {
+			tfMap["action_type"] = v.Type
+		}
+
+		if v := apiObject.ImagePushedAt; v != nil {
+			tfMap["image_pushed_at"] = aws.ToTime(v).Unix()
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
+)
+
+// addonConfigurationSchemas caches add-on configuration JSON schemas keyed by add-on name and version.
+// An add-on version's schema never changes, so entries are never evicted.
+var addonConfigurationSchemas = struct {
+	sync.Mutex
+	schemas map[string]string
+}{
+	schemas: make(map[string]string),
+}
+
+func findAddonConfigurationSchemaByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (string, error) {
+	key := addonName + ":" + addonVersion
+
+	addonConfigurationSchemas.Lock()
+	v, ok := addonConfigurationSchemas.schemas[key]
+	addonConfigurationSchemas.Unlock()
+
+	if ok {
+		return v, nil
+	}
+
+	// Don't hold the lock during the API call. Concurrent misses for the same key may each
+	// describe the add-on configuration, but they all store the same value.
+	output, err := findAddonConfigurationByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		return "", err
+	}
+
+	v = aws.ToString(output.ConfigurationSchema)
+
+	addonConfigurationSchemas.Lock()
+	addonConfigurationSchemas.schemas[key] = v
+	addonConfigurationSchemas.Unlock()
+
+	return v, nil
+}
+
+func findAddonConfigurationByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (*eks.DescribeAddonConfigurationOutput, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// resourceAddonConfigurationValuesCustomizeDiff validates configuration_values against the JSON schema
+// published for the planned add-on version, so that invalid keys are reported at plan time rather than
+// when the add-on create or update fails.
+func resourceAddonConfigurationValuesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("configuration_values") || !d.NewValueKnown("addon_name") || !d.NewValueKnown("addon_version") {
+		return nil
+	}
+
+	if !d.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	configurationValues := d.Get("configuration_values").(string)
+	addonName, addonVersion := d.Get("addon_name").(string), d.Get("addon_version").(string)
+
+	// Without an explicit version the add-on's default version is only known once the cluster exists.
+	if configurationValues == "" || addonVersion == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	configurationSchema, err := findAddonConfigurationSchemaByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	// The configuration schema requires the additional eks:DescribeAddonConfiguration permission.
+	if errs.IsA[*types.AccessDeniedException](err) {
+		log.Printf("[WARN] reading EKS Add-On (%s) version (%s) configuration schema, skipping configuration_values validation: %s", addonName, addonVersion, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Add-On (%s) version (%s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	if configurationSchema == "" {
+		return nil
+	}
+
+	if err := validateAddonConfigurationValues(configurationSchema, configurationValues); err != nil {
+		return fmt.Errorf("configuration_values are not valid for EKS Add-On (%s) version (%s): %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
+
+// validateAddonConfigurationValues validates JSON or YAML configuration values against an add-on
+// configuration JSON schema. Every violation is reported along with the path of the offending key.
+func validateAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	jsonSchema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(configurationSchema))
+
+	if err != nil {
+		return fmt.Errorf("loading configuration schema: %w", err)
+	}
+
+	value, err := decodeAddonConfigurationValues(configurationValues)
+
+	if err != nil {
+		return err
+	}
+
+	result, err := jsonSchema.Validate(gojsonschema.NewGoLoader(value))
+
+	if err != nil {
+		return fmt.Errorf("validating configuration values: %w", err)
+	}
+
+	var validationErrs []error
+
+	for _, v := range result.Errors() {
+		path := v.Field()
+
+		// The field of an additional property error is the enclosing object, so point at the property itself.
+		if v.Type() == "additional_property_not_allowed" {
+			if property, ok := v.Details()["property"].(string); ok {
+				if path == gojsonschema.STRING_CONTEXT_ROOT {
+					path = property
+				} else {
+					path += "." + property
+				}
+			}
+		}
+
+		validationErrs = append(validationErrs, fmt.Errorf("%s: %s", path, v.Description()))
+	}
+
+	return errors.Join(validationErrs...)
+}
+
+// decodeAddonConfigurationValues decodes configuration values, which EKS accepts as either JSON or YAML,
+// into the same generic representation that encoding/json produces.
+func decodeAddonConfigurationValues(s string) (interface{}, error) {
+	var value interface{}
+
+	if err := json.Unmarshal([]byte(s), &value); err == nil {
+		return value, nil
+	}
+
+	if err := yaml.Unmarshal([]byte(s), &value); err != nil {
+		return nil, fmt.Errorf("parsing configuration values: %w", err)
+	}
+
+	value, err := normalizeYAMLValue(value)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing configuration values: %w", err)
+	}
+
+	// Round-trip through JSON so that numbers are represented consistently.
+	b, err := json.Marshal(value)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing configuration values: %w", err)
+	}
+
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, fmt.Errorf("parsing configuration values: %w", err)
+	}
+
+	return value, nil
+}
+
+func normalizeYAMLValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+
+		for k, v := range value {
+			s, ok := k.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("unsupported non-string key: %v", k)
+			}
+
+			v, err := normalizeYAMLValue(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			m[s] = v
+		}
+
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(value))
+
+		for i, v := range value {
+			v, err := normalizeYAMLValue(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			s[i] = v
+		}
+
+		return s, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestValidateAddonConfigurationValues(t *testing.T) {
+	t.Parallel()
+
+	const configurationSchema = `{
+  "$schema": "http://json-schema.org/draft-06/schema#",
+  "additionalProperties": false,
+  "definitions": {
+    "Resources": {
+      "additionalProperties": false,
+      "properties": {
+        "limits": {"$ref": "#/definitions/Limits"}
+      },
+      "type": "object"
+    },
+    "Limits": {
+      "additionalProperties": false,
+      "properties": {
+        "cpu": {"type": "string"},
+        "memory": {"type": "string", "pattern": "^[0-9]+(Mi|Gi)$"}
+      },
+      "type": "object"
+    }
+  },
+  "properties": {
+    "replicaCount": {"type": "integer", "minimum": 1},
+    "logLevel": {"enum": ["debug", "info"]},
+    "env": {
+      "additionalProperties": false,
+      "properties": {
+        "WARM_ENI_TARGET": {"type": "string"}
+      },
+      "type": "object"
+    },
+    "tolerations": {
+      "items": {
+        "properties": {
+          "key": {"type": "string"}
+        },
+        "required": ["key"],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "podAnnotations": {
+      "additionalProperties": {"type": "string"},
+      "type": "object"
+    },
+    "resources": {"$ref": "#/definitions/Resources"},
+    "nodeSelector": {
+      "additionalProperties": false,
+      "patternProperties": {
+        "^[a-z./-]+$": {"type": "string"}
+      },
+      "type": "object"
+    },
+    "affinity": {
+      "oneOf": [
+        {"type": "null"},
+        {"type": "object"}
+      ]
+    }
+  },
+  "title": "Test",
+  "type": "object"
+}`
+
+	testCases := map[string]struct {
+		values        string
+		expectedError *regexp.Regexp
+	}{
+		"empty object": {
+			values: `{}`,
+		},
+		"valid JSON": {
+			values: `{"replicaCount": 2, "env": {"WARM_ENI_TARGET": "2"}, "resources": {"limits": {"cpu": "100m", "memory": "150Mi"}}}`,
+		},
+		"valid pattern properties": {
+			values: `{"nodeSelector": {"kubernetes.io/os": "linux"}, "affinity": null}`,
+		},
+		"valid YAML": {
+			values: "replicaCount: 2\ntolerations:\n- key: example\npodAnnotations:\n  a: b\n",
+		},
+		"unknown top-level property": {
+			values:        `{"replicas": 2}`,
+			expectedError: regexache.MustCompile(`replicas: Additional property replicas is not allowed`),
+		},
+		"unknown nested property": {
+			values:        `{"env": {"INVALID_FIELD": "2"}}`,
+			expectedError: regexache.MustCompile(`env\.INVALID_FIELD: Additional property INVALID_FIELD is not allowed`),
+		},
+		"unknown referenced property": {
+			values:        `{"resources": {"limits": {"cpuu": "100m"}}}`,
+			expectedError: regexache.MustCompile(`resources\.limits\.cpuu: Additional property cpuu is not allowed`),
+		},
+		"wrong type": {
+			values:        `{"env": {"WARM_ENI_TARGET": 2}}`,
+			expectedError: regexache.MustCompile(`env\.WARM_ENI_TARGET: Invalid type\. Expected: string, given: integer`),
+		},
+		"not an integer": {
+			values:        `{"replicaCount": 1.5}`,
+			expectedError: regexache.MustCompile(`replicaCount: Invalid type\. Expected: integer, given: number`),
+		},
+		"below minimum": {
+			values:        `{"replicaCount": 0}`,
+			expectedError: regexache.MustCompile(`replicaCount: Must be greater than or equal to 1`),
+		},
+		"not in enum": {
+			values:        `{"logLevel": "trace"}`,
+			expectedError: regexache.MustCompile(`logLevel: logLevel must be one of the following`),
+		},
+		"pattern mismatch": {
+			values:        `{"resources": {"limits": {"memory": "150MB"}}}`,
+			expectedError: regexache.MustCompile(`resources\.limits\.memory: Does not match pattern`),
+		},
+		"missing required property in array item": {
+			values:        "tolerations:\n- operator: Exists\n",
+			expectedError: regexache.MustCompile(`tolerations\.0: key is required`),
+		},
+		"additional properties schema": {
+			values:        `{"podAnnotations": {"a": 1}}`,
+			expectedError: regexache.MustCompile(`podAnnotations\.a: Invalid type\. Expected: string, given: integer`),
+		},
+		"pattern properties mismatch": {
+			values:        `{"nodeSelector": {"Invalid_Key": "linux"}}`,
+			expectedError: regexache.MustCompile(`nodeSelector\.Invalid_Key: Additional property Invalid_Key is not allowed`),
+		},
+		"one of mismatch": {
+			values:        `{"affinity": "any"}`,
+			expectedError: regexache.MustCompile(`affinity: Must validate one and only one schema`),
+		},
+		"not an object": {
+			values:        `[]`,
+			expectedError: regexache.MustCompile(`\(root\): Invalid type\. Expected: object, given: array`),
+		},
+		"invalid syntax": {
+			values:        `{"replicaCount":`,
+			expectedError: regexache.MustCompile(`parsing configuration values`),
+		},
+		"non-string key": {
+			values:        "env:\n  1: a\n",
+			expectedError: regexache.MustCompile(`unsupported non-string key: 1`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAddonConfigurationValues(configurationSchema, testCase.values)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %q", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -31,6 +31,10 @@ func dataSourceAddonVersion() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"configuration_schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kubernetes_version": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -61,11 +65,25 @@ func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On version info (%s, %s): %s", addonName, kubernetesVersion, err)
 	}
 
+	addonVersion := aws.ToString(versionInfo.AddonVersion)
+	configurationSchema, err := findAddonConfigurationSchemaByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	// The configuration schema requires the additional eks:DescribeAddonConfiguration permission.
+	if errs.IsA[*types.AccessDeniedException](err) || tfresource.NotFound(err) {
+		diags = sdkdiag.AppendWarningf(diags, "reading EKS Add-On (%s) version (%s) configuration schema: %s", addonName, addonVersion, err)
+		configurationSchema, err = "", nil
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s) version (%s) configuration schema: %s", addonName, addonVersion, err)
+	}
+
 	d.SetId(addonName)
 	d.Set("addon_name", addonName)
+	d.Set("configuration_schema", configurationSchema)
 	d.Set("kubernetes_version", kubernetesVersion)
 	d.Set(names.AttrMostRecent, mostRecent)
-	d.Set(names.AttrVersion, versionInfo.AddonVersion)
+	d.Set(names.AttrVersion, addonVersion)
 
 	return diags
 }
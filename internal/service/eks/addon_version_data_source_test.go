@@ -30,6 +30,7 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(versionDataSourceName, names.AttrVersion, addonDataSourceName, "addon_version"),
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "addon_name", addonDataSourceName, "addon_name"),
+					resource.TestCheckResourceAttrSet(versionDataSourceName, "configuration_schema"),
 					resource.TestCheckResourceAttr(versionDataSourceName, names.AttrMostRecent, acctest.CtTrue),
 				),
 			},
@@ -38,6 +39,7 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(versionDataSourceName, names.AttrVersion, addonDataSourceName, "addon_version"),
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "addon_name", addonDataSourceName, "addon_name"),
+					resource.TestCheckResourceAttrSet(versionDataSourceName, "configuration_schema"),
 					resource.TestCheckResourceAttr(versionDataSourceName, names.AttrMostRecent, acctest.CtFalse),
 				),
 			},
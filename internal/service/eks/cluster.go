@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -58,6 +59,7 @@ func resourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			validateClusterUpgradePreflight,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -288,6 +290,10 @@ func resourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"upgrade_preflight": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -504,9 +510,28 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	// Do any version update first.
 	if d.HasChange(names.AttrVersion) {
+		version := d.Get(names.AttrVersion).(string)
+
+		// Insights may have changed since the plan was made, so check again immediately before upgrading.
+		if d.Get("upgrade_preflight").(bool) {
+			insights, err := findClusterUpgradeBlockingInsights(ctx, conn, d.Id(), version)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) upgrade readiness insights: %s", d.Id(), err)
+			}
+
+			for _, v := range insights {
+				diags = sdkdiag.AppendErrorf(diags, "EKS Cluster (%s) upgrade to version %s blocked by %s", d.Id(), version, insightSummaryError(v))
+			}
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+
 		input := &eks.UpdateClusterVersionInput{
 			Name:    aws.String(d.Id()),
-			Version: aws.String(d.Get(names.AttrVersion).(string)),
+			Version: aws.String(version),
 		}
 
 		output, err := conn.UpdateClusterVersion(ctx, input)
@@ -865,6 +890,51 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id
 	return nil, err
 }
 
+// validateClusterUpgradePreflight prevents a planned Kubernetes version upgrade while any upgrade readiness
+// insight for the target version is in ERROR status.
+func validateClusterUpgradePreflight(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("upgrade_preflight").(bool) || !d.HasChange(names.AttrVersion) || !d.NewValueKnown(names.AttrVersion) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	version := d.Get(names.AttrVersion).(string)
+	insights, err := findClusterUpgradeBlockingInsights(ctx, conn, d.Id(), version)
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Cluster (%s) upgrade readiness insights: %w", d.Id(), err)
+	}
+
+	if len(insights) == 0 {
+		return nil
+	}
+
+	insightErrs := tfslices.ApplyToAll(insights, insightSummaryError)
+
+	return fmt.Errorf("EKS Cluster (%s) upgrade to version %s blocked by upgrade readiness insights: %w", d.Id(), version, errors.Join(insightErrs...))
+}
+
+func findClusterUpgradeBlockingInsights(ctx context.Context, conn *eks.Client, name, version string) ([]types.InsightSummary, error) {
+	filter := &types.InsightsFilter{
+		Categories:         []types.Category{types.CategoryUpgradeReadiness},
+		KubernetesVersions: []string{version},
+		Statuses:           []types.InsightStatusValue{types.InsightStatusValueError},
+	}
+
+	return findInsights(ctx, conn, name, filter)
+}
+
+func insightSummaryError(apiObject types.InsightSummary) error {
+	var reason string
+
+	if v := apiObject.InsightStatus; v != nil {
+		reason = aws.ToString(v.Reason)
+	}
+
+	return fmt.Errorf("%s (%s): %s", aws.ToString(apiObject.Name), aws.ToString(apiObject.Id), reason)
+}
+
 func expandCreateAccessConfigRequest(tfList []interface{}) *types.CreateAccessConfigRequest {
 	if len(tfList) == 0 {
 		return nil
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_eks_insights", name="Insights")
+func dataSourceInsights() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInsightsRead,
+
+		Schema: map[string]*schema.Schema{
+			"categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.Category](),
+				},
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+			"insights": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_info": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deprecation_details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_stats": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"last_request_time": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"number_of_requests_last_30_days": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"user_agent": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"replaced_with": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_serving_replacement_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"stop_serving_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"usage": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kubernetes_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_refresh_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_transition_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"recommendation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResources: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrARN: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kubernetes_resource_uri": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrStatus: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrStatusReason: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"kubernetes_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"statuses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.InsightStatusValue](),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInsightsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	filter := &types.InsightsFilter{}
+
+	if v, ok := d.GetOk("categories"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Categories = flex.ExpandStringyValueSet[types.Category](v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("kubernetes_versions"); ok && v.(*schema.Set).Len() > 0 {
+		filter.KubernetesVersions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("statuses"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Statuses = flex.ExpandStringyValueSet[types.InsightStatusValue](v.(*schema.Set))
+	}
+
+	summaries, err := findInsights(ctx, conn, clusterName, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) insights: %s", clusterName, err)
+	}
+
+	var insights []types.Insight
+
+	for _, v := range summaries {
+		id := aws.ToString(v.Id)
+		insight, err := findInsightByTwoPartKey(ctx, conn, clusterName, id)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) insight (%s): %s", clusterName, id, err)
+		}
+
+		insights = append(insights, *insight)
+	}
+
+	d.SetId(clusterName)
+	d.Set(names.AttrClusterName, clusterName)
+	if err := d.Set("insights", flattenInsights(insights)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting insights: %s", err)
+	}
+
+	return diags
+}
+
+func findInsights(ctx context.Context, conn *eks.Client, clusterName string, filter *types.InsightsFilter) ([]types.InsightSummary, error) {
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(clusterName),
+		Filter:      filter,
+	}
+	var output []types.InsightSummary
+
+	pages := eks.NewListInsightsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Insights...)
+	}
+
+	return output, nil
+}
+
+func findInsightByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, id string) (*types.Insight, error) {
+	input := &eks.DescribeInsightInput{
+		ClusterName: aws.String(clusterName),
+		Id:          aws.String(id),
+	}
+
+	output, err := conn.DescribeInsight(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Insight == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Insight, nil
+}
+
+func flattenInsights(apiObjects []types.Insight) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"additional_info":      apiObject.AdditionalInfo,
+			"category":             string(apiObject.Category),
+			names.AttrDescription:  aws.ToString(apiObject.Description),
+			names.AttrID:           aws.ToString(apiObject.Id),
+			"kubernetes_version":   aws.ToString(apiObject.KubernetesVersion),
+			"last_refresh_time":    flattenInsightTime(apiObject.LastRefreshTime),
+			"last_transition_time": flattenInsightTime(apiObject.LastTransitionTime),
+			names.AttrName:         aws.ToString(apiObject.Name),
+			"recommendation":       aws.ToString(apiObject.Recommendation),
+			names.AttrResources:    flattenInsightResourceDetails(apiObject.Resources),
+			names.AttrStatus:       "",
+			names.AttrStatusReason: "",
+			"deprecation_details":  []interface{}{},
+		}
+
+		if v := apiObject.CategorySpecificSummary; v != nil {
+			tfMap["deprecation_details"] = flattenDeprecationDetails(v.DeprecationDetails)
+		}
+
+		if v := apiObject.InsightStatus; v != nil {
+			tfMap[names.AttrStatus] = string(v.Status)
+			tfMap[names.AttrStatusReason] = aws.ToString(v.Reason)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDeprecationDetails(apiObjects []types.DeprecationDetail) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		var clientStats []interface{}
+
+		for _, v := range apiObject.ClientStats {
+			clientStats = append(clientStats, map[string]interface{}{
+				"last_request_time":               flattenInsightTime(v.LastRequestTime),
+				"number_of_requests_last_30_days": v.NumberOfRequestsLast30Days,
+				"user_agent":                      aws.ToString(v.UserAgent),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"client_stats":                      clientStats,
+			"replaced_with":                     aws.ToString(apiObject.ReplacedWith),
+			"start_serving_replacement_version": aws.ToString(apiObject.StartServingReplacementVersion),
+			"stop_serving_version":              aws.ToString(apiObject.StopServingVersion),
+			"usage":                             aws.ToString(apiObject.Usage),
+		})
+	}
+
+	return tfList
+}
+
+func flattenInsightResourceDetails(apiObjects []types.InsightResourceDetail) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrARN:             aws.ToString(apiObject.Arn),
+			"kubernetes_resource_uri": aws.ToString(apiObject.KubernetesResourceUri),
+		}
+
+		if v := apiObject.InsightStatus; v != nil {
+			tfMap[names.AttrStatus] = string(v.Status)
+			tfMap[names.AttrStatusReason] = aws.ToString(v.Reason)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenInsightTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.ToTime(t).Format(time.RFC3339)
+}
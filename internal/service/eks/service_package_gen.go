@@ -57,6 +57,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceClusters,
 			TypeName: "aws_eks_clusters",
 		},
+		{
+			Factory:  dataSourceInsights,
+			TypeName: "aws_eks_insights",
+			Name:     "Insights",
+		},
 		{
 			Factory:  dataSourceNodeGroup,
 			TypeName: "aws_eks_node_group",
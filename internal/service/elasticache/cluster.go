@@ -974,7 +974,7 @@ func setFromCacheCluster(d *schema.ResourceData, c *awstypes.CacheCluster) error
 	d.Set("node_type", c.CacheNodeType)
 
 	d.Set(names.AttrEngine, c.Engine)
-	if aws.ToString(c.Engine) == engineRedis {
+	if engine := aws.ToString(c.Engine); engine == engineRedis || engine == engineValkey {
 		if err := setEngineVersionRedis(d, c.EngineVersion); err != nil {
 			return err // nosemgrep:ci.bare-error-returns
 		}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -148,6 +149,45 @@ func engineVersionForceNewOnDowngrade(diff forceNewDiffer) error {
 	return diff.ForceNew(names.AttrEngineVersion)
 }
 
+// engineChangeIsInPlace returns whether the engine can be changed without re-creating the resource.
+// ElastiCache only supports changing the engine in place from Redis OSS to Valkey.
+func engineChangeIsInPlace(o, n string) bool {
+	return strings.EqualFold(o, engineRedis) && strings.EqualFold(n, engineValkey)
+}
+
+var valkeyVersionMin = gversion.Must(gversion.NewVersion("7.2"))
+
+// validateValkeyEngineVersion validates that `engine_version` is a Valkey engine version
+func validateValkeyEngineVersion(engineVersion string) (*gversion.Version, error) {
+	version, err := normalizeEngineVersion(engineVersion)
+	if err != nil {
+		return nil, fmt.Errorf("parsing engine_version: %w", err)
+	}
+
+	if version.LessThan(valkeyVersionMin) {
+		return nil, fmt.Errorf("engine_version %s is not supported by engine %s, use %s or later", engineVersion, engineValkey, valkeyVersionMin.Original())
+	}
+
+	return version, nil
+}
+
+// validateValkeyParameterGroupFamily validates that a parameter group family can be used by a Valkey engine version
+func validateValkeyParameterGroupFamily(family string, version *gversion.Version) error {
+	if version == nil {
+		if !strings.HasPrefix(family, engineValkey) {
+			return fmt.Errorf("parameter group family %s is not compatible with engine %s", family, engineValkey)
+		}
+
+		return nil
+	}
+
+	if expected := fmt.Sprintf("%s%d", engineValkey, version.Segments()[0]); family != expected {
+		return fmt.Errorf("parameter group family %s is not compatible with engine %s version %d, use a %s parameter group", family, engineValkey, version.Segments()[0], expected)
+	}
+
+	return nil
+}
+
 // normalizeEngineVersion returns a github.com/hashicorp/go-version Version from:
 // - a regular 1.2.3 version number
 // - either the 6.x or 6.0 version number used for ElastiCache Redis version 6. 6.x will sort to 6.<maxint>
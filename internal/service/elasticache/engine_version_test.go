@@ -912,3 +912,162 @@ func TestParamGroupNameRequiresMajorVersionUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestEngineChangeIsInPlace(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		old, new string
+		expected bool
+	}{
+		"redis to valkey": {
+			old:      "redis",
+			new:      "valkey",
+			expected: true,
+		},
+		"mixed case": {
+			old:      "Redis",
+			new:      "Valkey",
+			expected: true,
+		},
+		"valkey to redis": {
+			old: "valkey",
+			new: "redis",
+		},
+		"memcached to valkey": {
+			old: "memcached",
+			new: "valkey",
+		},
+		"new resource": {
+			old: "",
+			new: "valkey",
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if a, e := tfelasticache.EngineChangeIsInPlace(testcase.old, testcase.new), testcase.expected; a != e {
+				t.Errorf("expected %t, got %t", e, a)
+			}
+		})
+	}
+}
+
+func TestValidateValkeyEngineVersion(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		version     string
+		expectError *regexp.Regexp
+	}{
+		"7.2": {
+			version: "7.2",
+		},
+		"8.0": {
+			version: "8.0",
+		},
+		"7.1": {
+			version:     "7.1",
+			expectError: regexache.MustCompile(`engine_version 7\.1 is not supported by engine valkey, use 7\.2 or later`),
+		},
+		"6.x": {
+			version:     "6.x",
+			expectError: regexache.MustCompile(`engine_version 6\.x is not supported by engine valkey`),
+		},
+		"invalid": {
+			version:     "7.x",
+			expectError: regexache.MustCompile(`parsing engine_version`),
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tfelasticache.ValidateValkeyEngineVersion(testcase.version)
+
+			if testcase.expectError == nil {
+				if err != nil {
+					t.Fatalf("no error expected, got %s", err)
+				}
+			} else {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				if !testcase.expectError.MatchString(err.Error()) {
+					t.Fatalf("unexpected error: %q", err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateValkeyParameterGroupFamily(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		family      string
+		version     string
+		expectError *regexp.Regexp
+	}{
+		"valkey7, no version": {
+			family: "valkey7",
+		},
+		"redis7, no version": {
+			family:      "redis7",
+			expectError: regexache.MustCompile(`parameter group family redis7 is not compatible with engine valkey`),
+		},
+		"valkey7, version 7.2": {
+			family:  "valkey7",
+			version: "7.2",
+		},
+		"valkey8, version 8.0": {
+			family:  "valkey8",
+			version: "8.0",
+		},
+		"valkey7, version 8.0": {
+			family:      "valkey7",
+			version:     "8.0",
+			expectError: regexache.MustCompile(`parameter group family valkey7 is not compatible with engine valkey version 8, use a valkey8 parameter group`),
+		},
+		"redis7, version 7.2": {
+			family:      "redis7",
+			version:     "7.2",
+			expectError: regexache.MustCompile(`use a valkey7 parameter group`),
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var v *version.Version
+			if testcase.version != "" {
+				v = version.Must(version.NewVersion(testcase.version))
+			}
+
+			err := tfelasticache.ValidateValkeyParameterGroupFamily(testcase.family, v)
+
+			if testcase.expectError == nil {
+				if err != nil {
+					t.Fatalf("no error expected, got %s", err)
+				}
+			} else {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				if !testcase.expectError.MatchString(err.Error()) {
+					t.Fatalf("unexpected error: %q", err.Error())
+				}
+			}
+		})
+	}
+}
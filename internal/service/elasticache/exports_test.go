@@ -5,15 +5,16 @@ package elasticache
 
 // Exports for use in tests only.
 var (
-	ResourceCluster                = resourceCluster
-	ResourceGlobalReplicationGroup = resourceGlobalReplicationGroup
-	ResourceParameterGroup         = resourceParameterGroup
-	ResourceReplicationGroup       = resourceReplicationGroup
-	ResourceServerlessCache        = newServerlessCacheResource
-	ResourceSubnetGroup            = resourceSubnetGroup
-	ResourceUser                   = resourceUser
-	ResourceUserGroup              = resourceUserGroup
-	ResourceUserGroupAssociation   = resourceUserGroupAssociation
+	ResourceCluster                 = resourceCluster
+	ResourceGlobalReplicationGroup  = resourceGlobalReplicationGroup
+	ResourceParameterGroup          = resourceParameterGroup
+	ResourceReplicationGroup        = resourceReplicationGroup
+	ResourceServerlessCache         = newServerlessCacheResource
+	ResourceServerlessCacheSnapshot = newServerlessCacheSnapshotResource
+	ResourceSubnetGroup             = resourceSubnetGroup
+	ResourceUser                    = resourceUser
+	ResourceUserGroup               = resourceUserGroup
+	ResourceUserGroupAssociation    = resourceUserGroupAssociation
 
 	FindCacheClusterByID                 = findCacheClusterByID
 	FindCacheParameterGroup              = findCacheParameterGroup
@@ -22,6 +23,7 @@ var (
 	FindGlobalReplicationGroupByID       = findGlobalReplicationGroupByID
 	FindReplicationGroupByID             = findReplicationGroupByID
 	FindServerlessCacheByID              = findServerlessCacheByID
+	FindServerlessCacheSnapshotByName    = findServerlessCacheSnapshotByName
 	FindUserByID                         = findUserByID
 	FindUserGroupByID                    = findUserGroupByID
 	FindUserGroupAssociationByTwoPartKey = findUserGroupAssociationByTwoPartKey
@@ -33,6 +35,7 @@ var (
 	DeleteCacheCluster                        = deleteCacheCluster
 	DiffVersion                               = diffVersion
 	EmptyDescription                          = emptyDescription
+	EngineChangeIsInPlace                     = engineChangeIsInPlace
 	EngineMemcached                           = engineMemcached
 	EngineRedis                               = engineRedis
 	EngineVersionForceNewOnDowngrade          = engineVersionForceNewOnDowngrade
@@ -41,6 +44,8 @@ var (
 	NormalizeEngineVersion                    = normalizeEngineVersion
 	ParamGroupNameRequiresMajorVersionUpgrade = paramGroupNameRequiresMajorVersionUpgrade
 	ValidateClusterEngineVersion              = validateClusterEngineVersion
+	ValidateValkeyEngineVersion               = validateValkeyEngineVersion
+	ValidateValkeyParameterGroupFamily        = validateValkeyParameterGroupFamily
 	ValidMemcachedVersionString               = validMemcachedVersionString
 	ValidRedisVersionString                   = validRedisVersionString
 )
@@ -18,6 +18,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-cty/cty/gocty"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -124,9 +125,8 @@ func resourceReplicationGroup() *schema.Resource {
 			names.AttrEngine: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Default:      engineRedis,
-				ValidateFunc: validation.StringInSlice([]string{engineRedis}, true),
+				ValidateFunc: validation.StringInSlice([]string{engineRedis, engineValkey}, true),
 			},
 			names.AttrEngineVersion: {
 				Type:         schema.TypeString,
@@ -395,6 +395,7 @@ func resourceReplicationGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			replicationGroupValidateMultiAZAutomaticFailover,
+			replicationGroupEngineDiff,
 			customizeDiffEngineVersionForceNewOnDowngrade,
 			customdiff.ComputedIf("member_clusters", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("num_cache_clusters") ||
@@ -816,6 +817,16 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
+		// Changing the engine from Redis OSS to Valkey requires the Valkey engine version and parameter group.
+		if d.HasChange(names.AttrEngine) {
+			input.Engine = aws.String(d.Get(names.AttrEngine).(string))
+			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+			if v, ok := d.GetOk(names.AttrParameterGroupName); ok {
+				input.CacheParameterGroupName = aws.String(v.(string))
+			}
+			requestUpdate = true
+		}
+
 		if d.HasChange(names.AttrEngineVersion) {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 			requestUpdate = true
@@ -952,6 +963,13 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 				return sdkdiag.AppendErrorf(diags, "modifying ElastiCache Replication Group (%s): %s", d.Id(), err)
 			}
 
+			// A pending engine change is applied during the next maintenance window.
+			if input.Engine != nil && aws.ToBool(input.ApplyImmediately) {
+				if _, err := waitReplicationGroupEngineUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) engine update: %s", d.Id(), err)
+				}
+			}
+
 			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) update: %s", d.Id(), err)
 			}
@@ -1311,6 +1329,41 @@ func waitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Client
 	return nil, err
 }
 
+func statusReplicationGroupEngine(ctx context.Context, conn *elasticache.Client, replicationGroupID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReplicationGroupByID(ctx, conn, replicationGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strings.ToLower(aws.ToString(output.Engine)), nil
+	}
+}
+
+func waitReplicationGroupEngineUpdated(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration) (*awstypes.ReplicationGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{engineRedis},
+		Target:     []string{engineValkey},
+		Refresh:    statusReplicationGroupEngine(ctx, conn, replicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReplicationGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitReplicationGroupDeleted(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration) (*awstypes.ReplicationGroup, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -1414,6 +1467,57 @@ var validateReplicationGroupID schema.SchemaValidateFunc = validation.All(
 	validation.StringDoesNotMatch(regexache.MustCompile(`-$`), "cannot end with a hyphen"),
 )
 
+// replicationGroupEngineDiff allows an in-place change of engine from Redis OSS to Valkey,
+// validating that the engine version and parameter group are compatible with Valkey.
+// Any other change of engine forces a new resource.
+func replicationGroupEngineDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange(names.AttrEngine) {
+		if o, n := diff.GetChange(names.AttrEngine); !engineChangeIsInPlace(o.(string), n.(string)) {
+			return diff.ForceNew(names.AttrEngine)
+		}
+	}
+
+	if !strings.EqualFold(diff.Get(names.AttrEngine).(string), engineValkey) || !diff.HasChanges(names.AttrEngine, names.AttrEngineVersion, names.AttrParameterGroupName) {
+		return nil
+	}
+
+	if !diff.NewValueKnown(names.AttrEngineVersion) || !diff.NewValueKnown(names.AttrParameterGroupName) {
+		return nil
+	}
+
+	var version *gversion.Version
+	if v := diff.Get(names.AttrEngineVersion).(string); v != "" {
+		var err error
+		if version, err = validateValkeyEngineVersion(v); err != nil {
+			return err
+		}
+	}
+
+	name := diff.Get(names.AttrParameterGroupName).(string)
+	if name == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
+
+	parameterGroup, err := findCacheParameterGroupByName(ctx, conn, name)
+
+	// The parameter group may be created in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading ElastiCache Parameter Group (%s): %w", name, err)
+	}
+
+	if err := validateValkeyParameterGroupFamily(aws.ToString(parameterGroup.CacheParameterGroupFamily), version); err != nil {
+		return fmt.Errorf("parameter_group_name %s: %w", name, err)
+	}
+
+	return nil
+}
+
 // replicationGroupValidateMultiAZAutomaticFailover validates that `automatic_failover_enabled` is set when `multi_az_enabled` is true
 func replicationGroupValidateMultiAZAutomaticFailover(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	if v := diff.Get("multi_az_enabled").(bool); !v {
@@ -6,6 +6,8 @@ package elasticache
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -94,7 +97,9 @@ func (r *serverlessCacheResource) Schema(ctx context.Context, request resource.S
 			names.AttrEngine: schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						serverlessCacheEngineReplaceIf, "Replace engine diff", "Replace engine diff",
+					),
 				},
 			},
 			"full_engine_version": schema.StringAttribute{
@@ -115,7 +120,9 @@ func (r *serverlessCacheResource) Schema(ctx context.Context, request resource.S
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						serverlessCacheMajorEngineVersionReplaceIf, "Replace major_engine_version diff", "Replace major_engine_version diff",
+					),
 				},
 			},
 			names.AttrName: schema.StringAttribute{
@@ -342,6 +349,14 @@ func (r *serverlessCacheResource) Update(ctx context.Context, request resource.U
 			return
 		}
 
+		// Only send the engine and major engine version when they change.
+		if new.Engine.Equal(old.Engine) {
+			input.Engine = nil
+		}
+		if new.MajorEngineVersion.Equal(old.MajorEngineVersion) || new.MajorEngineVersion.IsUnknown() {
+			input.MajorEngineVersion = nil
+		}
+
 		_, err := conn.ModifyServerlessCache(ctx, input)
 
 		if err != nil {
@@ -350,6 +365,14 @@ func (r *serverlessCacheResource) Update(ctx context.Context, request resource.U
 			return
 		}
 
+		if input.Engine != nil {
+			if _, err := waitServerlessCacheEngineUpdated(ctx, conn, old.ServerlessCacheName.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) engine update", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
 		if _, err := waitServerlessCacheAvailable(ctx, conn, old.ServerlessCacheName.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) update", new.ID.ValueString()), err.Error())
 
@@ -415,9 +438,94 @@ func (r *serverlessCacheResource) Delete(ctx context.Context, request resource.D
 }
 
 func (r *serverlessCacheResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var plan, state serverlessCacheResourceModel
+		response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.Engine.Equal(state.Engine) && engineChangeIsInPlace(state.Engine.ValueString(), plan.Engine.ValueString()) {
+			var majorEngineVersion types.String
+			response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("major_engine_version"), &majorEngineVersion)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			// The engine version changes with the engine.
+			switch {
+			case majorEngineVersion.IsNull():
+				plan.MajorEngineVersion = types.StringUnknown()
+			case !majorEngineVersion.IsUnknown():
+				if err := validateServerlessCacheValkeyMajorEngineVersion(majorEngineVersion.ValueString()); err != nil {
+					response.Diagnostics.AddAttributeError(path.Root("major_engine_version"), "Invalid major_engine_version", err.Error())
+
+					return
+				}
+			}
+			plan.FullEngineVersion = types.StringUnknown()
+
+			response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	r.SetTagsAll(ctx, request, response)
 }
 
+func serverlessCacheEngineReplaceIf(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !engineChangeIsInPlace(req.StateValue.ValueString(), req.PlanValue.ValueString())
+}
+
+// serverlessCacheMajorEngineVersionReplaceIf allows the major engine version to be upgraded in place
+// together with a change of engine from Redis OSS to Valkey.
+func serverlessCacheMajorEngineVersionReplaceIf(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state serverlessCacheResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !engineChangeIsInPlace(state.Engine.ValueString(), plan.Engine.ValueString()) {
+		resp.RequiresReplace = true
+		return
+	}
+
+	o, err := strconv.Atoi(req.StateValue.ValueString())
+	if err != nil {
+		resp.RequiresReplace = true
+		return
+	}
+	n, err := strconv.Atoi(req.PlanValue.ValueString())
+	if err != nil {
+		resp.RequiresReplace = true
+		return
+	}
+
+	resp.RequiresReplace = n < o
+}
+
+// validateServerlessCacheValkeyMajorEngineVersion validates that `major_engine_version` is a Valkey major engine version
+func validateServerlessCacheValkeyMajorEngineVersion(majorEngineVersion string) error {
+	v, err := strconv.Atoi(majorEngineVersion)
+	if err != nil {
+		return fmt.Errorf("parsing major_engine_version: %w", err)
+	}
+
+	if minVersion := valkeyVersionMin.Segments()[0]; v < minVersion {
+		return fmt.Errorf("major_engine_version %s is not supported by engine %s, use %d or later", majorEngineVersion, engineValkey, minVersion)
+	}
+
+	return nil
+}
+
 func findServerlessCache(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeServerlessCachesInput) (*awstypes.ServerlessCache, error) {
 	output, err := findServerlessCaches(ctx, conn, input)
 
@@ -505,6 +613,40 @@ func waitServerlessCacheAvailable(ctx context.Context, conn *elasticache.Client,
 	return nil, err
 }
 
+func statusServerlessCacheEngine(ctx context.Context, conn *elasticache.Client, cacheClusterID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findServerlessCacheByID(ctx, conn, cacheClusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strings.ToLower(aws.ToString(output.Engine)), nil
+	}
+}
+
+func waitServerlessCacheEngineUpdated(ctx context.Context, conn *elasticache.Client, cacheClusterID string, timeout time.Duration) (*awstypes.ServerlessCache, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{engineRedis},
+		Target:     []string{engineValkey},
+		Refresh:    statusServerlessCacheEngine(ctx, conn, cacheClusterID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ServerlessCache); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitServerlessCacheDeleted(ctx context.Context, conn *elasticache.Client, cacheClusterID string, timeout time.Duration) (*awstypes.ServerlessCache, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -588,6 +730,8 @@ func serverlessCacheHasChanges(_ context.Context, plan, state serverlessCacheRes
 	return !plan.CacheUsageLimits.Equal(state.CacheUsageLimits) ||
 		!plan.DailySnapshotTime.Equal(state.DailySnapshotTime) ||
 		!plan.Description.Equal(state.Description) ||
+		!plan.Engine.Equal(state.Engine) ||
+		!plan.MajorEngineVersion.Equal(state.MajorEngineVersion) ||
 		!plan.UserGroupID.Equal(state.UserGroupID) ||
 		!plan.SecurityGroupIDs.Equal(state.SecurityGroupIDs) ||
 		!plan.SnapshotRetentionLimit.Equal(state.SnapshotRetentionLimit)
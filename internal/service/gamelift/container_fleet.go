@@ -0,0 +1,778 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_container_fleet", name="Container Fleet")
+// @Tags(identifierAttribute="arn")
+func resourceContainerFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContainerFleetCreate,
+		ReadWithoutTimeout:   resourceContainerFleetRead,
+		UpdateWithoutTimeout: resourceContainerFleetUpdate,
+		DeleteWithoutTimeout: resourceContainerFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Minute),
+			Update: schema.DefaultTimeout(70 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billing_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ContainerFleetBillingType](),
+			},
+			"deployment_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"impairment_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DeploymentImpairmentStrategy](),
+						},
+						"minimum_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(30, 75),
+						},
+						"protection_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DeploymentProtectionStrategy](),
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"fleet_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"game_server_container_group_definition_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"game_server_container_group_definition_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"game_server_container_groups_per_instance": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5000),
+			},
+			"game_session_creation_limit_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"new_game_sessions_per_creator": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"policy_period_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"instance_connection_port_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 60000),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 60000),
+						},
+					},
+				},
+			},
+			"instance_inbound_permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 60000),
+						},
+						"ip_range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						names.AttrProtocol: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.IpProtocol](),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 60000),
+						},
+					},
+				},
+			},
+			names.AttrInstanceType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrLocation: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+			"log_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_destination": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.LogDestination](),
+						},
+						"log_group_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrS3BucketName: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"maximum_game_server_container_groups_per_instance": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"metric_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			"new_game_session_protection_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ProtectionPolicy](),
+			},
+			"per_instance_container_group_definition_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"per_instance_container_group_definition_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceContainerFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	startTime := time.Now()
+	input := &gamelift.CreateContainerFleetInput{
+		FleetRoleArn: aws.String(d.Get("fleet_role_arn").(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("billing_type"); ok {
+		input.BillingType = awstypes.ContainerFleetBillingType(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_server_container_group_definition_name"); ok {
+		input.GameServerContainerGroupDefinitionName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_server_container_groups_per_instance"); ok {
+		input.GameServerContainerGroupsPerInstance = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("game_session_creation_limit_policy"); ok {
+		input.GameSessionCreationLimitPolicy = expandGameSessionCreationLimitPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("instance_connection_port_range"); ok {
+		input.InstanceConnectionPortRange = expandConnectionPortRange(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("instance_inbound_permission"); ok {
+		input.InstanceInboundPermissions = expandIPPermissions(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk(names.AttrInstanceType); ok {
+		input.InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrLocation); ok && v.(*schema.Set).Len() > 0 {
+		input.Locations = expandLocationConfigurations(flex.ExpandStringValueSet(v.(*schema.Set)))
+	}
+
+	if v, ok := d.GetOk("log_configuration"); ok {
+		input.LogConfiguration = expandLogConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("metric_groups"); ok {
+		input.MetricGroups = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("new_game_session_protection_policy"); ok {
+		input.NewGameSessionProtectionPolicy = awstypes.ProtectionPolicy(v.(string))
+	}
+
+	if v, ok := d.GetOk("per_instance_container_group_definition_name"); ok {
+		input.PerInstanceContainerGroupDefinitionName = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidRequestException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateContainerFleet(ctx, input)
+	}, "GameLift is not authorized to perform")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Container Fleet: %s", err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*gamelift.CreateContainerFleetOutput).ContainerFleet.FleetId))
+
+	if _, err := waitContainerFleetActive(ctx, conn, d.Id(), startTime, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceContainerFleetRead(ctx, d, meta)...)
+}
+
+func resourceContainerFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.GameLiftClient(ctx)
+
+	fleet, err := findContainerFleetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Container Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, fleet.FleetArn)
+	d.Set("billing_type", fleet.BillingType)
+	d.Set(names.AttrDescription, fleet.Description)
+	d.Set("fleet_role_arn", fleet.FleetRoleArn)
+	d.Set("game_server_container_group_definition_arn", fleet.GameServerContainerGroupDefinitionArn)
+	d.Set("game_server_container_group_definition_name", containerGroupDefinitionNameOrARN(d.Get("game_server_container_group_definition_name").(string), fleet.GameServerContainerGroupDefinitionName, fleet.GameServerContainerGroupDefinitionArn))
+	d.Set("game_server_container_groups_per_instance", fleet.GameServerContainerGroupsPerInstance)
+	if err := d.Set("game_session_creation_limit_policy", flattenGameSessionCreationLimitPolicy(fleet.GameSessionCreationLimitPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting game_session_creation_limit_policy: %s", err)
+	}
+	if err := d.Set("instance_connection_port_range", flattenConnectionPortRange(fleet.InstanceConnectionPortRange)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_connection_port_range: %s", err)
+	}
+	if err := d.Set("instance_inbound_permission", flattenIPPermissions(fleet.InstanceInboundPermissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_inbound_permission: %s", err)
+	}
+	d.Set(names.AttrInstanceType, fleet.InstanceType)
+	// The fleet's home Region is reported as a location but isn't configurable.
+	var locations []string
+	for _, v := range fleet.LocationAttributes {
+		if location := aws.ToString(v.Location); location != c.Region {
+			locations = append(locations, location)
+		}
+	}
+	d.Set(names.AttrLocation, locations)
+	if err := d.Set("log_configuration", flattenLogConfiguration(fleet.LogConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting log_configuration: %s", err)
+	}
+	d.Set("maximum_game_server_container_groups_per_instance", fleet.MaximumGameServerContainerGroupsPerInstance)
+	d.Set("metric_groups", fleet.MetricGroups)
+	d.Set("new_game_session_protection_policy", fleet.NewGameSessionProtectionPolicy)
+	d.Set("per_instance_container_group_definition_arn", fleet.PerInstanceContainerGroupDefinitionArn)
+	d.Set("per_instance_container_group_definition_name", containerGroupDefinitionNameOrARN(d.Get("per_instance_container_group_definition_name").(string), fleet.PerInstanceContainerGroupDefinitionName, fleet.PerInstanceContainerGroupDefinitionArn))
+	d.Set(names.AttrStatus, fleet.Status)
+
+	return diags
+}
+
+func resourceContainerFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	startTime := time.Now()
+
+	if d.HasChanges(names.AttrDescription, "game_server_container_group_definition_name", "game_server_container_groups_per_instance", "game_session_creation_limit_policy", "instance_connection_port_range", "instance_inbound_permission", "log_configuration", "metric_groups", "new_game_session_protection_policy", "per_instance_container_group_definition_name") {
+		input := &gamelift.UpdateContainerFleetInput{
+			FleetId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("deployment_configuration"); ok {
+			input.DeploymentConfiguration = expandDeploymentConfiguration(v.([]interface{}))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("game_server_container_group_definition_name") {
+			input.GameServerContainerGroupDefinitionName = aws.String(d.Get("game_server_container_group_definition_name").(string))
+		}
+
+		if d.HasChange("game_server_container_groups_per_instance") {
+			input.GameServerContainerGroupsPerInstance = aws.Int32(int32(d.Get("game_server_container_groups_per_instance").(int)))
+		}
+
+		if d.HasChange("game_session_creation_limit_policy") {
+			input.GameSessionCreationLimitPolicy = expandGameSessionCreationLimitPolicy(d.Get("game_session_creation_limit_policy").([]interface{}))
+		}
+
+		if d.HasChange("instance_connection_port_range") {
+			input.InstanceConnectionPortRange = expandConnectionPortRange(d.Get("instance_connection_port_range").([]interface{}))
+		}
+
+		if d.HasChange("instance_inbound_permission") {
+			o, n := d.GetChange("instance_inbound_permission")
+			input.InstanceInboundPermissionAuthorizations, input.InstanceInboundPermissionRevocations = diffPortSettings(o.(*schema.Set).List(), n.(*schema.Set).List())
+		}
+
+		if d.HasChange("log_configuration") {
+			input.LogConfiguration = expandLogConfiguration(d.Get("log_configuration").([]interface{}))
+		}
+
+		if d.HasChange("metric_groups") {
+			input.MetricGroups = flex.ExpandStringValueList(d.Get("metric_groups").([]interface{}))
+		}
+
+		if d.HasChange("new_game_session_protection_policy") {
+			input.NewGameSessionProtectionPolicy = awstypes.ProtectionPolicy(d.Get("new_game_session_protection_policy").(string))
+		}
+
+		if d.HasChange("per_instance_container_group_definition_name") {
+			if v, ok := d.GetOk("per_instance_container_group_definition_name"); ok {
+				input.PerInstanceContainerGroupDefinitionName = aws.String(v.(string))
+			} else {
+				input.RemoveAttributes = []awstypes.ContainerFleetRemoveAttribute{awstypes.ContainerFleetRemoveAttributePerInstanceContainerGroupDefinition}
+			}
+		}
+
+		_, err := conn.UpdateContainerFleet(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Container Fleet (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrLocation) {
+		o, n := d.GetChange(names.AttrLocation)
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			input := &gamelift.CreateFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: expandLocationConfigurations(add),
+			}
+
+			_, err := conn.CreateFleetLocations(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "adding GameLift Container Fleet (%s) locations: %s", d.Id(), err)
+			}
+		}
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			input := &gamelift.DeleteFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: del,
+			}
+
+			_, err := conn.DeleteFleetLocations(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing GameLift Container Fleet (%s) locations: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "deployment_configuration") {
+		if _, err := waitContainerFleetActive(ctx, conn, d.Id(), startTime, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceContainerFleetRead(ctx, d, meta)...)
+}
+
+func resourceContainerFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	startTime := time.Now()
+
+	log.Printf("[INFO] Deleting GameLift Container Fleet: %s", d.Id())
+	_, err := conn.DeleteContainerFleet(ctx, &gamelift.DeleteContainerFleetInput{
+		FleetId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GameLift Container Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitContainerFleetDeleted(ctx, conn, d.Id(), startTime, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// containerGroupDefinitionNameOrARN returns the container group definition ARN when the
+// configured value is an ARN, which pins a definition version, and the name otherwise.
+func containerGroupDefinitionNameOrARN(configured string, name, definitionARN *string) string {
+	if arn.IsARN(configured) {
+		return aws.ToString(definitionARN)
+	}
+
+	return aws.ToString(name)
+}
+
+func findContainerFleetByID(ctx context.Context, conn *gamelift.Client, id string) (*awstypes.ContainerFleet, error) {
+	input := &gamelift.DescribeContainerFleetInput{
+		FleetId: aws.String(id),
+	}
+
+	output, err := conn.DescribeContainerFleet(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContainerFleet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContainerFleet, nil
+}
+
+// statusContainerFleet reports the fleet status, or the status of the first
+// remote location that hasn't caught up with an ACTIVE fleet.
+func statusContainerFleet(ctx context.Context, conn *gamelift.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findContainerFleetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == awstypes.ContainerFleetStatusActive {
+			for _, v := range output.LocationAttributes {
+				if v.Status != awstypes.ContainerFleetLocationStatusActive {
+					return output, string(v.Status), nil
+				}
+			}
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitContainerFleetActive(ctx context.Context, conn *gamelift.Client, id string, startTime time.Time, timeout time.Duration) (*awstypes.ContainerFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.ContainerFleetStatusActivating,
+			awstypes.ContainerFleetStatusCreated,
+			awstypes.ContainerFleetStatusCreating,
+			awstypes.ContainerFleetStatusDeleting, // Removed locations report DELETING until they're gone.
+			awstypes.ContainerFleetStatusPending,
+			awstypes.ContainerFleetStatusUpdating,
+		),
+		Target:  enum.Slice(awstypes.ContainerFleetStatusActive),
+		Refresh: statusContainerFleet(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerFleet); ok {
+		if events, errFFF := findFleetFailuresByID(ctx, conn, id); errFFF == nil {
+			tfresource.SetLastError(err, fleetFailuresError(events, startTime))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerFleetDeleted(ctx context.Context, conn *gamelift.Client, id string, startTime time.Time, timeout time.Duration) (*awstypes.ContainerFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.ContainerFleetStatusActivating,
+			awstypes.ContainerFleetStatusActive,
+			awstypes.ContainerFleetStatusCreated,
+			awstypes.ContainerFleetStatusCreating,
+			awstypes.ContainerFleetStatusDeleting,
+			awstypes.ContainerFleetStatusPending,
+			awstypes.ContainerFleetStatusUpdating,
+		),
+		Target:  []string{},
+		Refresh: statusContainerFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerFleet); ok {
+		if events, errFFF := findFleetFailuresByID(ctx, conn, id); errFFF == nil {
+			tfresource.SetLastError(err, fleetFailuresError(events, startTime))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandConnectionPortRange(tfList []interface{}) *awstypes.ConnectionPortRange {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.ConnectionPortRange{
+		FromPort: aws.Int32(int32(tfMap["from_port"].(int))),
+		ToPort:   aws.Int32(int32(tfMap["to_port"].(int))),
+	}
+}
+
+func flattenConnectionPortRange(apiObject *awstypes.ConnectionPortRange) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"from_port": aws.ToInt32(apiObject.FromPort),
+		"to_port":   aws.ToInt32(apiObject.ToPort),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandDeploymentConfiguration(tfList []interface{}) *awstypes.DeploymentConfiguration {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.DeploymentConfiguration{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["impairment_strategy"].(string); ok && v != "" {
+		apiObject.ImpairmentStrategy = awstypes.DeploymentImpairmentStrategy(v)
+	}
+
+	if v, ok := tfMap["minimum_healthy_percentage"].(int); ok && v != 0 {
+		apiObject.MinimumHealthyPercentage = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["protection_strategy"].(string); ok && v != "" {
+		apiObject.ProtectionStrategy = awstypes.DeploymentProtectionStrategy(v)
+	}
+
+	return apiObject
+}
+
+func expandGameSessionCreationLimitPolicy(tfList []interface{}) *awstypes.GameSessionCreationLimitPolicy {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.GameSessionCreationLimitPolicy{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["new_game_sessions_per_creator"]; ok {
+		apiObject.NewGameSessionsPerCreator = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := tfMap["policy_period_in_minutes"]; ok {
+		apiObject.PolicyPeriodInMinutes = aws.Int32(int32(v.(int)))
+	}
+
+	return apiObject
+}
+
+func flattenGameSessionCreationLimitPolicy(apiObject *awstypes.GameSessionCreationLimitPolicy) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"new_game_sessions_per_creator": aws.ToInt32(apiObject.NewGameSessionsPerCreator),
+		"policy_period_in_minutes":      aws.ToInt32(apiObject.PolicyPeriodInMinutes),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandLocationConfigurations(locations []string) []awstypes.LocationConfiguration {
+	apiObjects := make([]awstypes.LocationConfiguration, 0, len(locations))
+
+	for _, location := range locations {
+		apiObjects = append(apiObjects, awstypes.LocationConfiguration{
+			Location: aws.String(location),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandLogConfiguration(tfList []interface{}) *awstypes.LogConfiguration {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.LogConfiguration{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["log_destination"].(string); ok && v != "" {
+		apiObject.LogDestination = awstypes.LogDestination(v)
+	}
+
+	if v, ok := tfMap["log_group_arn"].(string); ok && v != "" {
+		apiObject.LogGroupArn = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrS3BucketName].(string); ok && v != "" {
+		apiObject.S3BucketName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLogConfiguration(apiObject *awstypes.LogConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"log_destination":      apiObject.LogDestination,
+		"log_group_arn":        aws.ToString(apiObject.LogGroupArn),
+		names.AttrS3BucketName: aws.ToString(apiObject.S3BucketName),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGameLiftContainerFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf awstypes.ContainerFleet
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_fleet.test"
+	definitionResourceName := "aws_gamelift_container_group_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "gamelift", regexache.MustCompile(`fleet/containerfleet-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "billing_type", string(awstypes.ContainerFleetBillingTypeOnDemand)),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "game_server_container_group_definition_arn", definitionResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "game_server_container_group_definition_name", definitionResourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, "game_server_container_groups_per_instance"),
+					resource.TestCheckResourceAttr(resourceName, "instance_connection_port_range.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrInstanceType),
+					resource.TestCheckResourceAttr(resourceName, "location.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.log_destination", string(awstypes.LogDestinationCloudwatch)),
+					resource.TestCheckResourceAttrSet(resourceName, "maximum_game_server_container_groups_per_instance"),
+					resource.TestCheckResourceAttr(resourceName, "new_game_session_protection_policy", string(awstypes.ProtectionPolicyNoProtection)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ContainerFleetStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deployment_configuration"},
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf awstypes.ContainerFleet
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceContainerFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerFleet_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf awstypes.ContainerFleet
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "new_game_session_protection_policy", string(awstypes.ProtectionPolicyNoProtection)),
+				),
+			},
+			{
+				Config: testAccContainerFleetConfig_updated(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "game_session_creation_limit_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "game_session_creation_limit_policy.0.new_game_sessions_per_creator", "3"),
+					resource.TestCheckResourceAttr(resourceName, "game_session_creation_limit_policy.0.policy_period_in_minutes", "15"),
+					resource.TestCheckResourceAttr(resourceName, "metric_groups.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_groups.0", rName),
+					resource.TestCheckResourceAttr(resourceName, "new_game_session_protection_policy", string(awstypes.ProtectionPolicyFullProtection)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ContainerFleetStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deployment_configuration"},
+			},
+		},
+	})
+}
+
+func testAccCheckContainerFleetExists(ctx context.Context, n string, v *awstypes.ContainerFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		output, err := tfgamelift.FindContainerFleetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContainerFleetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_gamelift_container_fleet" {
+				continue
+			}
+
+			_, err := tfgamelift.FindContainerFleetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GameLift Container Fleet (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerFleetConfig_base(rName, imageURI string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "gamelift.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/GameLiftContainerFleetPolicy"
+}
+
+resource "aws_gamelift_container_group_definition" "test" {
+  name                         = %[1]q
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = 1024
+  total_vcpu_limit             = 1
+
+  game_server_container_definition {
+    container_name     = "server"
+    image_uri          = %[2]q
+    server_sdk_version = "5.2.0"
+
+    port_configuration {
+      container_port_range {
+        from_port = 7777
+        protocol  = "UDP"
+        to_port   = 7777
+      }
+    }
+  }
+}
+`, rName, imageURI)
+}
+
+func testAccContainerFleetConfig_basic(rName, imageURI string) string {
+	return acctest.ConfigCompose(testAccContainerFleetConfig_base(rName, imageURI), `
+resource "aws_gamelift_container_fleet" "test" {
+  fleet_role_arn                              = aws_iam_role.test.arn
+  game_server_container_group_definition_name = aws_gamelift_container_group_definition.test.name
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`)
+}
+
+func testAccContainerFleetConfig_updated(rName, imageURI string) string {
+	return acctest.ConfigCompose(testAccContainerFleetConfig_base(rName, imageURI), fmt.Sprintf(`
+resource "aws_gamelift_container_fleet" "test" {
+  description                                 = "updated"
+  fleet_role_arn                              = aws_iam_role.test.arn
+  game_server_container_group_definition_name = aws_gamelift_container_group_definition.test.name
+  metric_groups                               = [%[1]q]
+  new_game_session_protection_policy          = "FullProtection"
+
+  game_session_creation_limit_policy {
+    new_game_sessions_per_creator = 3
+    policy_period_in_minutes      = 15
+  }
+
+  tags = {
+    key1 = "value1"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
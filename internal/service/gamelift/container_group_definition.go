@@ -0,0 +1,926 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_container_group_definition", name="Container Group Definition")
+// @Tags(identifierAttribute="arn")
+func resourceContainerGroupDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContainerGroupDefinitionCreate,
+		ReadWithoutTimeout:   resourceContainerGroupDefinitionRead,
+		UpdateWithoutTimeout: resourceContainerGroupDefinitionUpdate,
+		DeleteWithoutTimeout: resourceContainerGroupDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			containerGroupDefinitionVersionDiff,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_group_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ContainerGroupType](),
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"game_server_container_definition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name":       containerNameSchema(),
+						"depends_on":           containerDependencySchema(),
+						"environment_override": containerEnvironmentSchema(),
+						"image_uri":            containerImageURISchema(),
+						"mount_point":          containerMountPointSchema(),
+						"port_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     containerPortConfigurationResource(),
+						},
+						"resolved_image_digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_sdk_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexache.MustCompile(`^\d+\.\d+\.\d+$`), "must be a version number such as 5.2.0"),
+							),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z\-]+$`), "must contain only alphanumeric characters and hyphens"),
+				),
+			},
+			"operating_system": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ContainerOperatingSystem](),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_container_definition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name":       containerNameSchema(),
+						"depends_on":           containerDependencySchema(),
+						"environment_override": containerEnvironmentSchema(),
+						"essential": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrHealthCheck: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 20,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+									names.AttrInterval: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(60, 300),
+									},
+									"retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(5, 10),
+									},
+									"start_period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 300),
+									},
+									names.AttrTimeout: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(30, 60),
+									},
+								},
+							},
+						},
+						"image_uri": containerImageURISchema(),
+						"memory_hard_limit_mebibytes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(4, 1024000),
+						},
+						"mount_point": containerMountPointSchema(),
+						"port_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     containerPortConfigurationResource(),
+						},
+						"resolved_image_digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcpu": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0.125, 10),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"total_memory_limit_mebibytes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(4, 1024000),
+			},
+			"total_vcpu_limit": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0.125, 10),
+			},
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func containerNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(1, 128),
+			validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z\-]+$`), "must contain only alphanumeric characters and hyphens"),
+		),
+	}
+}
+
+func containerImageURISchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+}
+
+func containerDependencySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrCondition: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.ContainerDependencyCondition](),
+				},
+				"container_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func containerEnvironmentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 20,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				names.AttrValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+		},
+	}
+}
+
+func containerMountPointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_level": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.ContainerMountPointAccessLevel](),
+				},
+				"container_path": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"instance_path": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+func containerPortConfigurationResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"container_port_range": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 60000),
+						},
+						names.AttrProtocol: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.IpProtocol](),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 60000),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceContainerGroupDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &gamelift.CreateContainerGroupDefinitionInput{
+		Name:                      aws.String(name),
+		OperatingSystem:           awstypes.ContainerOperatingSystem(d.Get("operating_system").(string)),
+		Tags:                      getTagsIn(ctx),
+		TotalMemoryLimitMebibytes: aws.Int32(int32(d.Get("total_memory_limit_mebibytes").(int))),
+		TotalVcpuLimit:            aws.Float64(d.Get("total_vcpu_limit").(float64)),
+	}
+
+	if v, ok := d.GetOk("container_group_type"); ok {
+		input.ContainerGroupType = awstypes.ContainerGroupType(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_server_container_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.GameServerContainerDefinition = expandGameServerContainerDefinitionInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("support_container_definition"); ok {
+		input.SupportContainerDefinitions = expandSupportContainerDefinitionInputs(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateContainerGroupDefinition(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Container Group Definition (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitContainerGroupDefinitionReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Group Definition (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceContainerGroupDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceContainerGroupDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	output, err := findContainerGroupDefinitionByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Container Group Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Group Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ContainerGroupDefinitionArn)
+	d.Set("container_group_type", output.ContainerGroupType)
+	if output.CreationTime != nil {
+		d.Set(names.AttrCreationTime, aws.ToTime(output.CreationTime).Format(time.RFC3339))
+	}
+	if err := d.Set("game_server_container_definition", flattenGameServerContainerDefinition(output.GameServerContainerDefinition)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting game_server_container_definition: %s", err)
+	}
+	d.Set(names.AttrName, output.Name)
+	d.Set("operating_system", output.OperatingSystem)
+	d.Set(names.AttrStatus, output.Status)
+	if err := d.Set("support_container_definition", flattenSupportContainerDefinitions(output.SupportContainerDefinitions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting support_container_definition: %s", err)
+	}
+	d.Set("total_memory_limit_mebibytes", output.TotalMemoryLimitMebibytes)
+	d.Set("total_vcpu_limit", output.TotalVcpuLimit)
+	d.Set("version_description", output.VersionDescription)
+	d.Set("version_number", output.VersionNumber)
+
+	return diags
+}
+
+func resourceContainerGroupDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// Each update creates a new version of the definition from the current one.
+		input := &gamelift.UpdateContainerGroupDefinitionInput{
+			Name:                        aws.String(d.Id()),
+			OperatingSystem:             awstypes.ContainerOperatingSystem(d.Get("operating_system").(string)),
+			SourceVersionNumber:         aws.Int32(int32(d.Get("version_number").(int))),
+			SupportContainerDefinitions: []awstypes.SupportContainerDefinitionInput{},
+			TotalMemoryLimitMebibytes:   aws.Int32(int32(d.Get("total_memory_limit_mebibytes").(int))),
+			TotalVcpuLimit:              aws.Float64(d.Get("total_vcpu_limit").(float64)),
+		}
+
+		if v, ok := d.GetOk("game_server_container_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.GameServerContainerDefinition = expandGameServerContainerDefinitionInput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("support_container_definition"); ok {
+			input.SupportContainerDefinitions = expandSupportContainerDefinitionInputs(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("version_description"); ok {
+			input.VersionDescription = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateContainerGroupDefinition(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Container Group Definition (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitContainerGroupDefinitionReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Group Definition (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceContainerGroupDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceContainerGroupDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	// Deleting without a version number deletes all versions of the definition.
+	log.Printf("[INFO] Deleting GameLift Container Group Definition: %s", d.Id())
+	_, err := conn.DeleteContainerGroupDefinition(ctx, &gamelift.DeleteContainerGroupDefinitionInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GameLift Container Group Definition (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitContainerGroupDefinitionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Group Definition (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// containerGroupDefinitionVersionDiff marks the attributes of the current definition version as unknown
+// when an update creates a new version, so that resources referencing them are updated in the same apply.
+func containerGroupDefinitionVersionDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	newVersion := false
+	for _, key := range d.GetChangedKeysPrefix("") {
+		if v := strings.SplitN(key, ".", 2)[0]; v != names.AttrTags && v != names.AttrTagsAll {
+			newVersion = true
+			break
+		}
+	}
+
+	if !newVersion {
+		return nil
+	}
+
+	// The image digests resolved for the new version are nested in the container definition blocks,
+	// which the SDK can't mark as unknown. They are set when the new version is read.
+	for _, key := range []string{names.AttrARN, names.AttrCreationTime, "version_number"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findContainerGroupDefinitionByName(ctx context.Context, conn *gamelift.Client, name string) (*awstypes.ContainerGroupDefinition, error) {
+	input := &gamelift.DescribeContainerGroupDefinitionInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeContainerGroupDefinition(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContainerGroupDefinition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContainerGroupDefinition, nil
+}
+
+func statusContainerGroupDefinition(ctx context.Context, conn *gamelift.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findContainerGroupDefinitionByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitContainerGroupDefinitionReady(ctx context.Context, conn *gamelift.Client, name string, timeout time.Duration) (*awstypes.ContainerGroupDefinition, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ContainerGroupDefinitionStatusCopying),
+		Target:  enum.Slice(awstypes.ContainerGroupDefinitionStatusReady),
+		Refresh: statusContainerGroupDefinition(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerGroupDefinition); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerGroupDefinitionDeleted(ctx context.Context, conn *gamelift.Client, name string, timeout time.Duration) (*awstypes.ContainerGroupDefinition, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.ContainerGroupDefinitionStatusCopying,
+			awstypes.ContainerGroupDefinitionStatusFailed,
+			awstypes.ContainerGroupDefinitionStatusReady,
+		),
+		Target:  []string{},
+		Refresh: statusContainerGroupDefinition(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerGroupDefinition); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandGameServerContainerDefinitionInput(tfMap map[string]interface{}) *awstypes.GameServerContainerDefinitionInput {
+	apiObject := &awstypes.GameServerContainerDefinitionInput{
+		ContainerName:    aws.String(tfMap["container_name"].(string)),
+		ImageUri:         aws.String(tfMap["image_uri"].(string)),
+		ServerSdkVersion: aws.String(tfMap["server_sdk_version"].(string)),
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DependsOn = expandContainerDependencies(v)
+	}
+
+	if v, ok := tfMap["environment_override"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnvironmentOverride = expandContainerEnvironments(v.List())
+	}
+
+	if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MountPoints = expandContainerMountPoints(v)
+	}
+
+	if v, ok := tfMap["port_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PortConfiguration = expandContainerPortConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSupportContainerDefinitionInputs(tfList []interface{}) []awstypes.SupportContainerDefinitionInput {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.SupportContainerDefinitionInput
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandSupportContainerDefinitionInput(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandSupportContainerDefinitionInput(tfMap map[string]interface{}) awstypes.SupportContainerDefinitionInput {
+	apiObject := awstypes.SupportContainerDefinitionInput{
+		ContainerName: aws.String(tfMap["container_name"].(string)),
+		ImageUri:      aws.String(tfMap["image_uri"].(string)),
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DependsOn = expandContainerDependencies(v)
+	}
+
+	if v, ok := tfMap["environment_override"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnvironmentOverride = expandContainerEnvironments(v.List())
+	}
+
+	if v, ok := tfMap["essential"].(bool); ok {
+		apiObject.Essential = aws.Bool(v)
+	}
+
+	if v, ok := tfMap[names.AttrHealthCheck].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandContainerHealthCheck(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["memory_hard_limit_mebibytes"].(int); ok && v != 0 {
+		apiObject.MemoryHardLimitMebibytes = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MountPoints = expandContainerMountPoints(v)
+	}
+
+	if v, ok := tfMap["port_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PortConfiguration = expandContainerPortConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["vcpu"].(float64); ok && v != 0 {
+		apiObject.Vcpu = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerDependencies(tfList []interface{}) []awstypes.ContainerDependency {
+	var apiObjects []awstypes.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.ContainerDependency{
+			Condition:     awstypes.ContainerDependencyCondition(tfMap[names.AttrCondition].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerEnvironments(tfList []interface{}) []awstypes.ContainerEnvironment {
+	var apiObjects []awstypes.ContainerEnvironment
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.ContainerEnvironment{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerHealthCheck(tfMap map[string]interface{}) *awstypes.ContainerHealthCheck {
+	apiObject := &awstypes.ContainerHealthCheck{
+		Command: flex.ExpandStringValueList(tfMap["command"].([]interface{})),
+	}
+
+	if v, ok := tfMap[names.AttrInterval].(int); ok && v != 0 {
+		apiObject.Interval = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v != 0 {
+		apiObject.Retries = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrTimeout].(int); ok && v != 0 {
+		apiObject.Timeout = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerMountPoints(tfList []interface{}) []awstypes.ContainerMountPoint {
+	var apiObjects []awstypes.ContainerMountPoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ContainerMountPoint{
+			InstancePath: aws.String(tfMap["instance_path"].(string)),
+		}
+
+		if v, ok := tfMap["access_level"].(string); ok && v != "" {
+			apiObject.AccessLevel = awstypes.ContainerMountPointAccessLevel(v)
+		}
+
+		if v, ok := tfMap["container_path"].(string); ok && v != "" {
+			apiObject.ContainerPath = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerPortConfiguration(tfMap map[string]interface{}) *awstypes.ContainerPortConfiguration {
+	apiObject := &awstypes.ContainerPortConfiguration{}
+
+	if v, ok := tfMap["container_port_range"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.ContainerPortRanges = append(apiObject.ContainerPortRanges, awstypes.ContainerPortRange{
+				FromPort: aws.Int32(int32(tfMap["from_port"].(int))),
+				Protocol: awstypes.IpProtocol(tfMap[names.AttrProtocol].(string)),
+				ToPort:   aws.Int32(int32(tfMap["to_port"].(int))),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenGameServerContainerDefinition(apiObject *awstypes.GameServerContainerDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"container_name":        aws.ToString(apiObject.ContainerName),
+		"depends_on":            flattenContainerDependencies(apiObject.DependsOn),
+		"environment_override":  flattenContainerEnvironments(apiObject.EnvironmentOverride),
+		"image_uri":             aws.ToString(apiObject.ImageUri),
+		"mount_point":           flattenContainerMountPoints(apiObject.MountPoints),
+		"port_configuration":    flattenContainerPortConfiguration(apiObject.PortConfiguration),
+		"resolved_image_digest": aws.ToString(apiObject.ResolvedImageDigest),
+		"server_sdk_version":    aws.ToString(apiObject.ServerSdkVersion),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSupportContainerDefinitions(apiObjects []awstypes.SupportContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenSupportContainerDefinition(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenSupportContainerDefinition(apiObject awstypes.SupportContainerDefinition) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"container_name":              aws.ToString(apiObject.ContainerName),
+		"depends_on":                  flattenContainerDependencies(apiObject.DependsOn),
+		"environment_override":        flattenContainerEnvironments(apiObject.EnvironmentOverride),
+		"essential":                   aws.ToBool(apiObject.Essential),
+		"image_uri":                   aws.ToString(apiObject.ImageUri),
+		"memory_hard_limit_mebibytes": aws.ToInt32(apiObject.MemoryHardLimitMebibytes),
+		"mount_point":                 flattenContainerMountPoints(apiObject.MountPoints),
+		"port_configuration":          flattenContainerPortConfiguration(apiObject.PortConfiguration),
+		"resolved_image_digest":       aws.ToString(apiObject.ResolvedImageDigest),
+		"vcpu":                        aws.ToFloat64(apiObject.Vcpu),
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap[names.AttrHealthCheck] = []interface{}{map[string]interface{}{
+			"command":          v.Command,
+			names.AttrInterval: aws.ToInt32(v.Interval),
+			"retries":          aws.ToInt32(v.Retries),
+			"start_period":     aws.ToInt32(v.StartPeriod),
+			names.AttrTimeout:  aws.ToInt32(v.Timeout),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenContainerDependencies(apiObjects []awstypes.ContainerDependency) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrCondition: apiObject.Condition,
+			"container_name":    aws.ToString(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerEnvironments(apiObjects []awstypes.ContainerEnvironment) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:  aws.ToString(apiObject.Name),
+			names.AttrValue: aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerMountPoints(apiObjects []awstypes.ContainerMountPoint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"access_level":   apiObject.AccessLevel,
+			"container_path": aws.ToString(apiObject.ContainerPath),
+			"instance_path":  aws.ToString(apiObject.InstancePath),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerPortConfiguration(apiObject *awstypes.ContainerPortConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.ContainerPortRanges {
+		tfList = append(tfList, map[string]interface{}{
+			"from_port":        aws.ToInt32(v.FromPort),
+			names.AttrProtocol: v.Protocol,
+			"to_port":          aws.ToInt32(v.ToPort),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"container_port_range": tfList,
+	}}
+}
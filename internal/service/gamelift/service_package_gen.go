@@ -44,6 +44,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceContainerFleet,
+			TypeName: "aws_gamelift_container_fleet",
+			Name:     "Container Fleet",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceContainerGroupDefinition,
+			TypeName: "aws_gamelift_container_group_definition",
+			Name:     "Container Group Definition",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceFleet,
 			TypeName: "aws_gamelift_fleet",
@@ -33,6 +33,20 @@ func RegisterSweepers() {
 		F:    sweepScripts,
 	})
 
+	resource.AddTestSweepers("aws_gamelift_container_fleet", &resource.Sweeper{
+		Name: "aws_gamelift_container_fleet",
+		F:    sweepContainerFleets,
+	})
+
+	resource.AddTestSweepers("aws_gamelift_container_group_definition", &resource.Sweeper{
+		Name: "aws_gamelift_container_group_definition",
+		Dependencies: []string{
+			"aws_gamelift_container_fleet",
+			"aws_gamelift_fleet",
+		},
+		F: sweepContainerGroupDefinitions,
+	})
+
 	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
@@ -175,6 +189,88 @@ func sweepScripts(region string) error {
 	return nil
 }
 
+func sweepContainerFleets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &gamelift.ListContainerFleetsInput{}
+	conn := client.GameLiftClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := gamelift.NewListContainerFleetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping GameLift Container Fleet sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing GameLift Container Fleets (%s): %w", region, err)
+		}
+
+		for _, v := range page.ContainerFleets {
+			r := resourceContainerFleet()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.FleetId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GameLift Container Fleets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepContainerGroupDefinitions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &gamelift.ListContainerGroupDefinitionsInput{}
+	conn := client.GameLiftClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := gamelift.NewListContainerGroupDefinitionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping GameLift Container Group Definition sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing GameLift Container Group Definitions (%s): %w", region, err)
+		}
+
+		for _, v := range page.ContainerGroupDefinitions {
+			r := resourceContainerGroupDefinition()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GameLift Container Group Definitions (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepFleets(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
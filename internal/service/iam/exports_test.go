@@ -18,6 +18,7 @@ var (
 	ResourcePolicyAttachment          = resourcePolicyAttachment
 	ResourceRolePolicy                = resourceRolePolicy
 	ResourceRolePolicyAttachment      = resourceRolePolicyAttachment
+	ResourceRoleTrustPolicyStatement  = resourceRoleTrustPolicyStatement
 	ResourceSAMLProvider              = resourceSAMLProvider
 	ResourceServerCertificate         = resourceServerCertificate
 	ResourceServiceLinkedRole         = resourceServiceLinkedRole
@@ -31,23 +32,24 @@ var (
 	ResourceUserSSHKey                = resourceUserSSHKey
 	ResourceVirtualMFADevice          = resourceVirtualMFADevice
 
-	FindAccessKeyByTwoPartKey           = findAccessKeyByTwoPartKey
-	FindAccountPasswordPolicy           = findAccountPasswordPolicy
-	FindAttachedGroupPolicies           = findAttachedGroupPolicies
-	FindAttachedGroupPolicyByTwoPartKey = findAttachedGroupPolicyByTwoPartKey
-	FindAttachedRolePolicies            = findAttachedRolePolicies
-	FindAttachedRolePolicyByTwoPartKey  = findAttachedRolePolicyByTwoPartKey
-	FindAttachedUserPolicies            = findAttachedUserPolicies
-	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
-	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
-	FindGroupByName                     = findGroupByName
-	FindInstanceProfileByName           = findInstanceProfileByName
-	FindOpenIDConnectProviderByARN      = findOpenIDConnectProviderByARN
-	FindPolicyByARN                     = findPolicyByARN
-	FindSAMLProviderByARN               = findSAMLProviderByARN
-	FindServerCertificateByName         = findServerCertificateByName
-	FindSSHPublicKeyByThreePartKey      = findSSHPublicKeyByThreePartKey
-	FindUserByName                      = findUserByName
-	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
-	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
+	FindAccessKeyByTwoPartKey                = findAccessKeyByTwoPartKey
+	FindAccountPasswordPolicy                = findAccountPasswordPolicy
+	FindAttachedGroupPolicies                = findAttachedGroupPolicies
+	FindAttachedGroupPolicyByTwoPartKey      = findAttachedGroupPolicyByTwoPartKey
+	FindAttachedRolePolicies                 = findAttachedRolePolicies
+	FindAttachedRolePolicyByTwoPartKey       = findAttachedRolePolicyByTwoPartKey
+	FindAttachedUserPolicies                 = findAttachedUserPolicies
+	FindAttachedUserPolicyByTwoPartKey       = findAttachedUserPolicyByTwoPartKey
+	FindEntitiesForPolicyByARN               = findEntitiesForPolicyByARN
+	FindGroupByName                          = findGroupByName
+	FindInstanceProfileByName                = findInstanceProfileByName
+	FindOpenIDConnectProviderByARN           = findOpenIDConnectProviderByARN
+	FindPolicyByARN                          = findPolicyByARN
+	FindRoleTrustPolicyStatementByTwoPartKey = findRoleTrustPolicyStatementByTwoPartKey
+	FindSAMLProviderByARN                    = findSAMLProviderByARN
+	FindServerCertificateByName              = findServerCertificateByName
+	FindSSHPublicKeyByThreePartKey           = findSSHPublicKeyByThreePartKey
+	FindUserByName                           = findUserByName
+	FindVirtualMFADeviceBySerialNumber       = findVirtualMFADeviceBySerialNumber
+	SESSMTPPasswordFromSecretKeySigV4        = sesSMTPPasswordFromSecretKeySigV4
 )
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iam_role_trust_policy_statement", name="Role Trust Policy Statement")
+func resourceRoleTrustPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRoleTrustPolicyStatementCreate,
+		ReadWithoutTimeout:   resourceRoleTrustPolicyStatementRead,
+		UpdateWithoutTimeout: resourceRoleTrustPolicyStatementUpdate,
+		DeleteWithoutTimeout: resourceRoleTrustPolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrRole: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+			"sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"statement": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyStatementDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+		},
+	}
+}
+
+func resourceRoleTrustPolicyStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	roleName, sid := d.Get(names.AttrRole).(string), d.Get("sid").(string)
+	statement := d.Get("statement").(string)
+
+	mutexKey := "iam_role_trust_policy_" + roleName
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findRoleTrustPolicyByName(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) assume role policy: %s", roleName, err)
+	}
+
+	if _, ok, err := verify.PolicyStatementBySID(policy, sid); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	} else if ok {
+		return sdkdiag.AppendErrorf(diags, "creating IAM Role Trust Policy Statement: IAM Role (%s) assume role policy statement (%s) already exists", roleName, sid)
+	}
+
+	policy, err = verify.PolicyWithStatement(policy, sid, statement)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := updateRoleTrustPolicy(ctx, conn, roleName, policy); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating IAM Role (%s) assume role policy statement (%s): %s", roleName, sid, err)
+	}
+
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{roleName, sid}, roleTrustPolicyStatementResourceIDPartCount, false)))
+
+	if err := waitRoleTrustPolicyStatementPropagated(ctx, conn, roleName, sid, statement); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Role Trust Policy Statement (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRoleTrustPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceRoleTrustPolicyStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	roleName, sid := d.Get(names.AttrRole).(string), d.Get("sid").(string)
+	statement := d.Get("statement").(string)
+
+	mutexKey := "iam_role_trust_policy_" + roleName
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findRoleTrustPolicyByName(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) assume role policy: %s", roleName, err)
+	}
+
+	policy, err = verify.PolicyWithStatement(policy, sid, statement)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := updateRoleTrustPolicy(ctx, conn, roleName, policy); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating IAM Role (%s) assume role policy statement (%s): %s", roleName, sid, err)
+	}
+
+	if err := waitRoleTrustPolicyStatementPropagated(ctx, conn, roleName, sid, statement); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Role Trust Policy Statement (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRoleTrustPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceRoleTrustPolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), roleTrustPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	roleName, sid := parts[0], parts[1]
+
+	statement, err := findRoleTrustPolicyStatementByTwoPartKey(ctx, conn, roleName, sid)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Trust Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Trust Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	statement, err = verify.PolicyStatementToSet(sid, d.Get("statement").(string), statement)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrRole, roleName)
+	d.Set("sid", sid)
+	d.Set("statement", statement)
+
+	return diags
+}
+
+func resourceRoleTrustPolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), roleTrustPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	roleName, sid := parts[0], parts[1]
+
+	mutexKey := "iam_role_trust_policy_" + roleName
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findRoleTrustPolicyByName(ctx, conn, roleName)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) assume role policy: %s", roleName, err)
+	}
+
+	if _, ok, _ := verify.PolicyStatementBySID(policy, sid); !ok {
+		return diags
+	}
+
+	policy, n, err := verify.PolicyWithoutStatement(policy, sid)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// A role's trust policy must contain at least one statement and cannot be removed.
+	if n == 0 {
+		return sdkdiag.AppendWarningf(diags, "IAM Role Trust Policy Statement (%s) is the only statement in the role's trust policy and was not removed from it; IAM roles must have a trust policy with at least one statement", d.Id())
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Role Trust Policy Statement: %s", d.Id())
+	err = updateRoleTrustPolicy(ctx, conn, roleName, policy)
+
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IAM Role Trust Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findRoleTrustPolicyStatementByTwoPartKey(ctx, conn, roleName, sid)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Role Trust Policy Statement (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const roleTrustPolicyStatementResourceIDPartCount = 2
+
+func updateRoleTrustPolicy(ctx context.Context, conn *iam.Client, roleName, policy string) error {
+	input := &iam.UpdateAssumeRolePolicyInput{
+		PolicyDocument: aws.String(policy),
+		RoleName:       aws.String(roleName),
+	}
+
+	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.UpdateAssumeRolePolicy(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsAErrorMessageContains[*awstypes.MalformedPolicyDocumentException](err, "Invalid principal in policy") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	return err
+}
+
+func findRoleTrustPolicyByName(ctx context.Context, conn *iam.Client, roleName string) (string, error) {
+	role, err := findRoleByName(ctx, conn, roleName)
+
+	if err != nil {
+		return "", err
+	}
+
+	policy, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
+
+	if err != nil {
+		return "", fmt.Errorf("decoding assume role policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+func findRoleTrustPolicyStatementByTwoPartKey(ctx context.Context, conn *iam.Client, roleName, sid string) (string, error) {
+	policy, err := findRoleTrustPolicyByName(ctx, conn, roleName)
+
+	if err != nil {
+		return "", err
+	}
+
+	statement, ok, err := verify.PolicyStatementBySID(policy, sid)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", &retry.NotFoundError{}
+	}
+
+	return statement, nil
+}
+
+func waitRoleTrustPolicyStatementPropagated(ctx context.Context, conn *iam.Client, roleName, sid, statement string) error {
+	return tfresource.WaitUntil(ctx, propagationTimeout, func() (bool, error) {
+		output, err := findRoleTrustPolicyStatementByTwoPartKey(ctx, conn, roleName, sid)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return verify.PolicyStatementsEquivalent(sid, output, statement), nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                1 * time.Second,
+	})
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMRoleTrustPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_iam_role_trust_policy_statement.test1"
+	resource2Name := "aws_iam_role_trust_policy_statement.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleTrustPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleTrustPolicyStatementConfig_basic(rName, "lambda.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleTrustPolicyStatementExists(ctx, resource1Name),
+					testAccCheckRoleTrustPolicyStatementExists(ctx, resource2Name),
+					resource.TestCheckResourceAttrPair(resource1Name, names.AttrRole, "aws_iam_role.test", names.AttrName),
+					resource.TestCheckResourceAttr(resource1Name, "sid", "Service"),
+					resource.TestCheckResourceAttr(resource2Name, "sid", "Account"),
+				),
+			},
+			{
+				ResourceName:      resource1Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoleTrustPolicyStatementConfig_basic(rName, "ecs-tasks.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleTrustPolicyStatementExists(ctx, resource1Name),
+					testAccCheckRoleTrustPolicyStatementExists(ctx, resource2Name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRoleTrustPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_trust_policy_statement.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleTrustPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleTrustPolicyStatementConfig_basic(rName, "lambda.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleTrustPolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiam.ResourceRoleTrustPolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRoleTrustPolicyStatement_lastStatement(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_trust_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleTrustPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleTrustPolicyStatementConfig_lastStatement(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleTrustPolicyStatementExists(ctx, resourceName),
+					testAccCheckRoleTrustPolicyOnlyStatement(ctx, resourceName),
+				),
+			},
+			{
+				// The statement is removed from state but stays in the role's trust policy.
+				Config: testAccRoleTrustPolicyStatementConfig_lastStatement(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleTrustPolicyStatementInRole(ctx, "aws_iam_role.test", "Service"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoleTrustPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iam_role_trust_policy_statement" {
+				continue
+			}
+
+			_, err := tfiam.FindRoleTrustPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrRole], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IAM Role Trust Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRoleTrustPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		_, err := tfiam.FindRoleTrustPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrRole], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+// testAccCheckRoleTrustPolicyOnlyStatement replaces the role's trust policy with one containing only the statement.
+func testAccCheckRoleTrustPolicyOnlyStatement(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		statement, err := tfiam.FindRoleTrustPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrRole], rs.Primary.Attributes["sid"])
+
+		if err != nil {
+			return err
+		}
+
+		input := &iam.UpdateAssumeRolePolicyInput{
+			PolicyDocument: aws.String(fmt.Sprintf(`{"Version":"2012-10-17","Statement":[%s]}`, statement)),
+			RoleName:       aws.String(rs.Primary.Attributes[names.AttrRole]),
+		}
+
+		_, err = conn.UpdateAssumeRolePolicy(ctx, input)
+
+		return err
+	}
+}
+
+func testAccCheckRoleTrustPolicyStatementInRole(ctx context.Context, n, sid string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		_, err := tfiam.FindRoleTrustPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.ID, sid)
+
+		return err
+	}
+}
+
+func testAccRoleTrustPolicyStatementConfig_basic(rName, service string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "EC2"
+      Effect    = "Allow"
+      Principal = { Service = "ec2.amazonaws.com" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+
+  lifecycle {
+    ignore_changes = [assume_role_policy]
+  }
+}
+
+resource "aws_iam_role_trust_policy_statement" "test1" {
+  role = aws_iam_role.test.name
+  sid  = "Service"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { Service = %[2]q }
+    Action    = "sts:AssumeRole"
+  })
+}
+
+resource "aws_iam_role_trust_policy_statement" "test2" {
+  role = aws_iam_role.test.name
+  sid  = "Account"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+    Action    = "sts:AssumeRole"
+  })
+}
+`, rName, service)
+}
+
+func testAccRoleTrustPolicyStatementConfig_lastStatement(rName string, statement bool) string {
+	config := fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "EC2"
+      Effect    = "Allow"
+      Principal = { Service = "ec2.amazonaws.com" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+
+  lifecycle {
+    ignore_changes = [assume_role_policy]
+  }
+}
+`, rName)
+
+	if !statement {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, `
+resource "aws_iam_role_trust_policy_statement" "test" {
+  role = aws_iam_role.test.name
+  sid  = "Service"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { Service = "lambda.amazonaws.com" }
+    Action    = "sts:AssumeRole"
+  })
+}
+`)
+}
@@ -204,6 +204,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
 		},
+		{
+			Factory:  resourceRoleTrustPolicyStatement,
+			TypeName: "aws_iam_role_trust_policy_statement",
+			Name:     "Role Trust Policy Statement",
+		},
 		{
 			Factory:  resourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
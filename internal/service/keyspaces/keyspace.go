@@ -6,6 +6,7 @@ package keyspaces
 import (
 	"context"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -13,12 +14,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/keyspaces"
 	"github.com/aws/aws-sdk-go-v2/service/keyspaces/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,10 +44,29 @@ func resourceKeyspace() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ForceNewIfChange("replication_specification.0.region_list", func(_ context.Context, old, new, meta interface{}) bool {
+				// Regions can only be added.
+				if os, ok := old.(*schema.Set); ok {
+					if ns, ok := new.(*schema.Set); ok {
+						if del := os.Difference(ns); del.Len() > 0 {
+							return true
+						}
+					}
+				}
+
+				return false
+			}),
+			customdiff.ForceNewIfChange("replication_specification.0.replication_strategy", func(_ context.Context, old, new, meta interface{}) bool {
+				// A multi-Region keyspace cannot be converted back to a single-Region keyspace.
+				return old.(string) == string(types.RsMultiRegion) && new.(string) == string(types.RsSingleRegion)
+			}),
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -59,6 +82,31 @@ func resourceKeyspace() *schema.Resource {
 					"The name can have up to 48 characters. It must begin with an alpha-numeric character and can only contain alpha-numeric characters and underscores.",
 				),
 			},
+			"replication_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"replication_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.Rs](),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -76,6 +124,10 @@ func resourceKeyspaceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		Tags:         getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("replication_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ReplicationSpecification = expandReplicationSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.CreateKeyspace(ctx, input)
 
 	if err != nil {
@@ -114,13 +166,45 @@ func resourceKeyspaceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set(names.AttrARN, keyspace.ResourceArn)
 	d.Set(names.AttrName, keyspace.KeyspaceName)
+	if err := d.Set("replication_specification", []interface{}{flattenKeyspaceReplicationSpecification(keyspace)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replication_specification: %s", err)
+	}
 
 	return diags
 }
 
 func resourceKeyspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
-	return resourceKeyspaceRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KeyspacesClient(ctx)
+
+	if d.HasChange("replication_specification") {
+		// https://docs.aws.amazon.com/keyspaces/latest/APIReference/API_UpdateKeyspace.html
+		// Regions can only be added, and every table in the keyspace must use client-side timestamps.
+		regions := flex.ExpandStringValueSet(d.Get("replication_specification.0.region_list").(*schema.Set))
+		input := &keyspaces.UpdateKeyspaceInput{
+			ClientSideTimestamps: &types.ClientSideTimestamps{
+				Status: types.ClientSideTimestampsStatusEnabled,
+			},
+			KeyspaceName: aws.String(d.Id()),
+			ReplicationSpecification: &types.ReplicationSpecification{
+				RegionList:          regions,
+				ReplicationStrategy: types.RsMultiRegion,
+			},
+		}
+
+		_, err := conn.UpdateKeyspace(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Keyspaces Keyspace (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitKeyspaceUpdated(ctx, conn, d.Id(), regions, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Keyspace (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceKeyspaceRead(ctx, d, meta)...)
 }
 
 func resourceKeyspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -180,3 +264,81 @@ func findKeyspaceByName(ctx context.Context, conn *keyspaces.Client, name string
 
 	return output, nil
 }
+
+func statusKeyspace(ctx context.Context, conn *keyspaces.Client, name string, regions []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findKeyspaceByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The new Regions are not reported until replication to them has started.
+		for _, region := range regions {
+			if !slices.Contains(output.ReplicationRegions, region) {
+				return output, string(types.KeyspaceStatusUpdating), nil
+			}
+		}
+
+		for _, v := range output.ReplicationGroupStatuses {
+			if status := v.KeyspaceStatus; status != types.KeyspaceStatusActive {
+				return output, string(status), nil
+			}
+		}
+
+		return output, string(types.KeyspaceStatusActive), nil
+	}
+}
+
+func waitKeyspaceUpdated(ctx context.Context, conn *keyspaces.Client, name string, regions []string, timeout time.Duration) (*keyspaces.GetKeyspaceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.KeyspaceStatusCreating, types.KeyspaceStatusUpdating),
+		Target:  enum.Slice(types.KeyspaceStatusActive),
+		Refresh: statusKeyspace(ctx, conn, name, regions),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*keyspaces.GetKeyspaceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandReplicationSpecification(tfMap map[string]interface{}) *types.ReplicationSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ReplicationSpecification{}
+
+	if v, ok := tfMap["region_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RegionList = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["replication_strategy"].(string); ok && v != "" {
+		apiObject.ReplicationStrategy = types.Rs(v)
+	}
+
+	return apiObject
+}
+
+func flattenKeyspaceReplicationSpecification(apiObject *keyspaces.GetKeyspaceOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"region_list":          apiObject.ReplicationRegions,
+		"replication_strategy": apiObject.ReplicationStrategy,
+	}
+
+	return tfMap
+}
@@ -6,15 +6,19 @@ package keyspaces_test
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfkeyspaces "github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -120,6 +124,120 @@ func TestAccKeyspacesKeyspace_tags(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesKeyspace_replicationSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_keyspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyspaceConfig_replicationSpecification(rName, acctest.Region(), acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replication_strategy", "MULTI_REGION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKeyspaceConfig_replicationSpecification(rName, acctest.Region(), acctest.AlternateRegion(), acctest.ThirdRegion()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.ThirdRegion()),
+				),
+			},
+			{
+				Config: testAccKeyspaceConfig_replicationSpecification(rName, acctest.Region(), acctest.AlternateRegion()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyspacesKeyspace_replicationSpecificationWithTable(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_keyspace.test"
+	tableResourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyspaceConfig_replicationSpecificationWithTable(rName1, rName2, acctest.Region(), acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccKeyspaceConfig_replicationSpecificationWithTable(rName1, rName2, acctest.Region(), acctest.AlternateRegion(), acctest.ThirdRegion()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(tableResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", acctest.Ct3),
+				),
+			},
+			{
+				// Refreshing the table picks up the client-side timestamps enabled by the new Region without planning a replacement.
+				Config: testAccKeyspaceConfig_replicationSpecificationWithTable(rName1, rName2, acctest.Region(), acctest.AlternateRegion(), acctest.ThirdRegion()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tableResourceName, "client_side_timestamps.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(tableResourceName, "client_side_timestamps.0.status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeyspaceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).KeyspacesClient(ctx)
@@ -197,3 +315,36 @@ resource "aws_keyspaces_keyspace" "test" {
 }
 `, rName, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func testAccKeyspaceConfig_replicationSpecification(rName string, regions ...string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  replication_specification {
+    replication_strategy = "MULTI_REGION"
+    region_list          = [%[2]s]
+  }
+}
+`, rName, strings.Join(tfslices.ApplyToAll(regions, strconv.Quote), ", "))
+}
+
+func testAccKeyspaceConfig_replicationSpecificationWithTable(rName1, rName2 string, regions ...string) string {
+	return acctest.ConfigCompose(testAccKeyspaceConfig_replicationSpecification(rName1, regions...), fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+}
+`, rName2))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -49,10 +50,6 @@ func resourceTable() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
-			customdiff.ForceNewIfChange("client_side_timestamps", func(_ context.Context, old, new, meta interface{}) bool {
-				// Client-side timestamps cannot be disabled.
-				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
-			}),
 			customdiff.ForceNewIfChange("schema_definition.0.column", func(_ context.Context, old, new, meta interface{}) bool {
 				// Columns can only be added.
 				if os, ok := old.(*schema.Set); ok {
@@ -65,6 +62,9 @@ func resourceTable() *schema.Resource {
 
 				return false
 			}),
+			customdiff.ComputedIf("latest_stream_arn", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("cdc_specification")
+			}),
 			verify.SetTagsDiff,
 		),
 
@@ -73,6 +73,17 @@ func resourceTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_scaling_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_auto_scaling":  autoScalingSettingsSchema(),
+						"write_capacity_auto_scaling": autoScalingSettingsSchema(),
+					},
+				},
+			},
 			"capacity_specification": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -99,9 +110,36 @@ func resourceTable() *schema.Resource {
 					},
 				},
 			},
+			"cdc_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"propagate_tags": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.CdcPropagateTags](),
+						},
+						names.AttrStatus: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(types.CdcStatusEnabled, types.CdcStatusDisabled), false),
+						},
+						"view_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.ViewType](),
+						},
+					},
+				},
+			},
+			// Client-side timestamps cannot be disabled, and adding a Region to the keyspace enables them on every table.
 			"client_side_timestamps": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -181,6 +219,25 @@ func resourceTable() *schema.Resource {
 					},
 				},
 			},
+			"replica_specification": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_auto_scaling": autoScalingSettingsSchema(),
+						"read_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						names.AttrRegion: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+					},
+				},
+			},
 			"schema_definition": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -274,6 +331,10 @@ func resourceTable() *schema.Resource {
 					},
 				},
 			},
+			"latest_stream_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTableName: {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -303,6 +364,67 @@ func resourceTable() *schema.Resource {
 	}
 }
 
+func autoScalingSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auto_scaling_disabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"maximum_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"minimum_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"scaling_policy": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"target_tracking_scaling_policy_configuration": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"disable_scale_in": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"scale_in_cooldown": {
+											Type:     schema.TypeInt,
+											Optional: true,
+										},
+										"scale_out_cooldown": {
+											Type:     schema.TypeInt,
+											Optional: true,
+										},
+										"target_value": {
+											Type:         schema.TypeFloat,
+											Required:     true,
+											ValidateFunc: validation.FloatBetween(20, 90),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -317,10 +439,18 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Tags:         getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoScalingSpecification = expandAutoScalingSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("capacity_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.CapacitySpecification = expandCapacitySpecification(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("cdc_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CdcSpecification = expandCdcSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("client_side_timestamps"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.ClientSideTimestamps = expandClientSideTimestamps(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -341,6 +471,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.PointInTimeRecovery = expandPointInTimeRecovery(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("replica_specification"); ok && v.(*schema.Set).Len() > 0 {
+		input.ReplicaSpecifications = expandReplicaSpecifications(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("schema_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.SchemaDefinition = expandSchemaDefinition(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -361,6 +495,12 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) create: %s", d.Id(), err)
 	}
 
+	if input.CdcSpecification != nil && input.CdcSpecification.Status == types.CdcStatusEnabled {
+		if _, err := waitTableCDCUpdated(ctx, conn, keyspaceName, tableName, types.CdcStatusEnabled, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) CdcSpecification create: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceTableRead(ctx, d, meta)...)
 }
 
@@ -388,6 +528,25 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	d.Set(names.AttrARN, table.ResourceArn)
+
+	// Auto scaling settings are only read if they are configured, so that tables without auto scaling
+	// don't require the additional application-autoscaling permissions.
+	var autoScaling *keyspaces.GetTableAutoScalingSettingsOutput
+	if table.CapacitySpecification != nil && table.CapacitySpecification.ThroughputMode == types.ThroughputModeProvisioned && tableAutoScalingConfigured(d) {
+		autoScaling, err = findTableAutoScalingSettingsByTwoPartKey(ctx, conn, keyspaceName, tableName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Keyspaces Table (%s) auto scaling settings: %s", d.Id(), err)
+		}
+	}
+
+	if autoScaling != nil && autoScalingSpecificationEnabled(autoScaling.AutoScalingSpecification) {
+		if err := d.Set("auto_scaling_specification", []interface{}{flattenAutoScalingSpecification(autoScaling.AutoScalingSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting auto_scaling_specification: %s", err)
+		}
+	} else {
+		d.Set("auto_scaling_specification", nil)
+	}
 	if table.CapacitySpecification != nil {
 		if err := d.Set("capacity_specification", []interface{}{flattenCapacitySpecificationSummary(table.CapacitySpecification)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting capacity_specification: %s", err)
@@ -395,6 +554,17 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	} else {
 		d.Set("capacity_specification", nil)
 	}
+	// A table that has never had CDC configured reports a DISABLED status.
+	if table.CdcSpecification != nil && (table.CdcSpecification.Status != types.CdcStatusDisabled || len(d.Get("cdc_specification").([]interface{})) > 0) {
+		tfMap := flattenCdcSpecificationSummary(table.CdcSpecification)
+		// propagate_tags is not returned by the API, so the configured value is kept.
+		tfMap["propagate_tags"] = d.Get("cdc_specification.0.propagate_tags").(string)
+		if err := d.Set("cdc_specification", []interface{}{tfMap}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cdc_specification: %s", err)
+		}
+	} else {
+		d.Set("cdc_specification", nil)
+	}
 	if table.ClientSideTimestamps != nil {
 		if err := d.Set("client_side_timestamps", []interface{}{flattenClientSideTimestamps(table.ClientSideTimestamps)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting client_side_timestamps: %s", err)
@@ -418,6 +588,7 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		d.Set("encryption_specification", nil)
 	}
 	d.Set("keyspace_name", table.KeyspaceName)
+	d.Set("latest_stream_arn", table.LatestStreamArn)
 	if table.PointInTimeRecovery != nil {
 		if err := d.Set("point_in_time_recovery", []interface{}{flattenPointInTimeRecoverySummary(table.PointInTimeRecovery)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting point_in_time_recovery: %s", err)
@@ -425,6 +596,14 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	} else {
 		d.Set("point_in_time_recovery", nil)
 	}
+	// Only the configured Regions are tracked. Every Region of a multi-Region keyspace has a replica of the table.
+	var replicaAutoScaling []types.ReplicaAutoScalingSpecification
+	if autoScaling != nil {
+		replicaAutoScaling = autoScaling.ReplicaSpecifications
+	}
+	if err := d.Set("replica_specification", flattenReplicaSpecificationSummaries(table.ReplicaSpecifications, replicaAutoScaling, d.Get("replica_specification").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replica_specification: %s", err)
+	}
 	if table.SchemaDefinition != nil {
 		if err := d.Set("schema_definition", []interface{}{flattenSchemaDefinition(table.SchemaDefinition)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting schema_definition: %s", err)
@@ -458,6 +637,34 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		// https://docs.aws.amazon.com/keyspaces/latest/APIReference/API_UpdateTable.html
 		// Note that you can only update one specific table setting per update operation.
+		if d.HasChange("auto_scaling_specification") {
+			var autoScalingSpecification *types.AutoScalingSpecification
+			if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				autoScalingSpecification = expandAutoScalingSpecification(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				autoScalingSpecification = &types.AutoScalingSpecification{
+					ReadCapacityAutoScaling:  &types.AutoScalingSettings{AutoScalingDisabled: true},
+					WriteCapacityAutoScaling: &types.AutoScalingSettings{AutoScalingDisabled: true},
+				}
+			}
+
+			input := &keyspaces.UpdateTableInput{
+				AutoScalingSpecification: autoScalingSpecification,
+				KeyspaceName:             aws.String(keyspaceName),
+				TableName:                aws.String(tableName),
+			}
+
+			_, err := conn.UpdateTable(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Keyspaces Table (%s) AutoScalingSpecification: %s", d.Id(), err)
+			}
+
+			if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) AutoScalingSpecification update: %s", d.Id(), err)
+			}
+		}
+
 		if d.HasChange("capacity_specification") {
 			if v, ok := d.GetOk("capacity_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input := &keyspaces.UpdateTableInput{
@@ -478,6 +685,37 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 
+		if d.HasChange("cdc_specification") {
+			var cdcSpecification *types.CdcSpecification
+			if v, ok := d.GetOk("cdc_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				cdcSpecification = expandCdcSpecification(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				cdcSpecification = &types.CdcSpecification{
+					Status: types.CdcStatusDisabled,
+				}
+			}
+
+			input := &keyspaces.UpdateTableInput{
+				CdcSpecification: cdcSpecification,
+				KeyspaceName:     aws.String(keyspaceName),
+				TableName:        aws.String(tableName),
+			}
+
+			_, err := conn.UpdateTable(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Keyspaces Table (%s) CdcSpecification: %s", d.Id(), err)
+			}
+
+			if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) CdcSpecification update: %s", d.Id(), err)
+			}
+
+			if _, err := waitTableCDCUpdated(ctx, conn, keyspaceName, tableName, cdcSpecification.Status, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) CdcSpecification update: %s", d.Id(), err)
+			}
+		}
+
 		if d.HasChange("client_side_timestamps") {
 			if v, ok := d.GetOk("client_side_timestamps"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input := &keyspaces.UpdateTableInput{
@@ -556,6 +794,26 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 
+		if d.HasChange("replica_specification") {
+			if v, ok := d.GetOk("replica_specification"); ok && v.(*schema.Set).Len() > 0 {
+				input := &keyspaces.UpdateTableInput{
+					KeyspaceName:          aws.String(keyspaceName),
+					ReplicaSpecifications: expandReplicaSpecifications(v.(*schema.Set).List()),
+					TableName:             aws.String(tableName),
+				}
+
+				_, err := conn.UpdateTable(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating Keyspaces Table (%s) ReplicaSpecifications: %s", d.Id(), err)
+				}
+
+				if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) ReplicaSpecifications update: %s", d.Id(), err)
+				}
+			}
+		}
+
 		if d.HasChange("ttl") {
 			if v, ok := d.GetOk("ttl"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input := &keyspaces.UpdateTableInput{
@@ -700,6 +958,54 @@ func findTableByTwoPartKey(ctx context.Context, conn *keyspaces.Client, keyspace
 	return output, nil
 }
 
+// tableAutoScalingConfigured returns whether auto scaling is configured for the table or any of its replicas.
+func tableAutoScalingConfigured(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return true
+	}
+
+	if v, ok := d.GetOk("replica_specification"); ok {
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap["read_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func findTableAutoScalingSettingsByTwoPartKey(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string) (*keyspaces.GetTableAutoScalingSettingsOutput, error) {
+	input := keyspaces.GetTableAutoScalingSettingsInput{
+		KeyspaceName: aws.String(keyspaceName),
+		TableName:    aws.String(tableName),
+	}
+
+	output, err := conn.GetTableAutoScalingSettings(ctx, &input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func statusTable(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findTableByTwoPartKey(ctx, conn, keyspaceName, tableName)
@@ -768,6 +1074,182 @@ func waitTableUpdated(ctx context.Context, conn *keyspaces.Client, keyspaceName,
 	return nil, err
 }
 
+func statusTableCDC(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTableByTwoPartKey(ctx, conn, keyspaceName, tableName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.CdcSpecification == nil {
+			return output, string(types.CdcStatusDisabled), nil
+		}
+
+		return output, string(output.CdcSpecification.Status), nil
+	}
+}
+
+func waitTableCDCUpdated(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string, status types.CdcStatus, timeout time.Duration) (*keyspaces.GetTableOutput, error) { //nolint:unparam
+	// The previous status may still be reported immediately after the update.
+	pending := enum.Slice(types.CdcStatusEnabling, types.CdcStatusDisabling, types.CdcStatusEnabled, types.CdcStatusDisabled)
+	pending = slices.DeleteFunc(pending, func(v string) bool {
+		return v == string(status)
+	})
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  enum.Slice(status),
+		Refresh: statusTableCDC(ctx, conn, keyspaceName, tableName),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*keyspaces.GetTableOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAutoScalingSpecification(tfMap map[string]interface{}) *types.AutoScalingSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AutoScalingSpecification{}
+
+	if v, ok := tfMap["read_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ReadCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["write_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WriteCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScalingSettings(tfMap map[string]interface{}) *types.AutoScalingSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AutoScalingSettings{}
+
+	if v, ok := tfMap["auto_scaling_disabled"].(bool); ok {
+		apiObject.AutoScalingDisabled = v
+	}
+
+	if v, ok := tfMap["maximum_units"].(int); ok && v != 0 {
+		apiObject.MaximumUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minimum_units"].(int); ok && v != 0 {
+		apiObject.MinimumUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scaling_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ScalingPolicy = expandAutoScalingPolicy(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScalingPolicy(tfMap map[string]interface{}) *types.AutoScalingPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AutoScalingPolicy{}
+
+	if v, ok := tfMap["target_tracking_scaling_policy_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TargetTrackingScalingPolicyConfiguration = expandTargetTrackingScalingPolicyConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTargetTrackingScalingPolicyConfiguration(tfMap map[string]interface{}) *types.TargetTrackingScalingPolicyConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.TargetTrackingScalingPolicyConfiguration{}
+
+	if v, ok := tfMap["disable_scale_in"].(bool); ok {
+		apiObject.DisableScaleIn = v
+	}
+
+	if v, ok := tfMap["scale_in_cooldown"].(int); ok {
+		apiObject.ScaleInCooldown = int32(v)
+	}
+
+	if v, ok := tfMap["scale_out_cooldown"].(int); ok {
+		apiObject.ScaleOutCooldown = int32(v)
+	}
+
+	if v, ok := tfMap["target_value"].(float64); ok {
+		apiObject.TargetValue = v
+	}
+
+	return apiObject
+}
+
+func expandReplicaSpecification(tfMap map[string]interface{}) *types.ReplicaSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ReplicaSpecification{}
+
+	if v, ok := tfMap["read_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ReadCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["read_capacity_units"].(int); ok && v != 0 {
+		apiObject.ReadCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandReplicaSpecifications(tfList []interface{}) []types.ReplicaSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.ReplicaSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandReplicaSpecification(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandCapacitySpecification(tfMap map[string]interface{}) *types.CapacitySpecification {
 	if tfMap == nil {
 		return nil
@@ -790,6 +1272,28 @@ func expandCapacitySpecification(tfMap map[string]interface{}) *types.CapacitySp
 	return apiObject
 }
 
+func expandCdcSpecification(tfMap map[string]interface{}) *types.CdcSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CdcSpecification{}
+
+	if v, ok := tfMap["propagate_tags"].(string); ok && v != "" {
+		apiObject.PropagateTags = types.CdcPropagateTags(v)
+	}
+
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
+		apiObject.Status = types.CdcStatus(v)
+	}
+
+	if v, ok := tfMap["view_type"].(string); ok && v != "" {
+		apiObject.ViewType = types.ViewType(v)
+	}
+
+	return apiObject
+}
+
 func expandClientSideTimestamps(tfMap map[string]interface{}) *types.ClientSideTimestamps {
 	if tfMap == nil {
 		return nil
@@ -1058,6 +1562,142 @@ func expandStaticColumns(tfList []interface{}) []types.StaticColumn {
 	return apiObjects
 }
 
+// autoScalingSpecificationEnabled returns whether auto scaling is enabled for either read or write capacity.
+func autoScalingSpecificationEnabled(apiObject *types.AutoScalingSpecification) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	for _, v := range []*types.AutoScalingSettings{apiObject.ReadCapacityAutoScaling, apiObject.WriteCapacityAutoScaling} {
+		if v != nil && !v.AutoScalingDisabled {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenAutoScalingSpecification(apiObject *types.AutoScalingSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ReadCapacityAutoScaling; v != nil {
+		tfMap["read_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+	}
+
+	if v := apiObject.WriteCapacityAutoScaling; v != nil {
+		tfMap["write_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAutoScalingSettings(apiObject *types.AutoScalingSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"auto_scaling_disabled": apiObject.AutoScalingDisabled,
+	}
+
+	if v := apiObject.MaximumUnits; v != nil {
+		tfMap["maximum_units"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.MinimumUnits; v != nil {
+		tfMap["minimum_units"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.ScalingPolicy; v != nil {
+		tfMap["scaling_policy"] = []interface{}{flattenAutoScalingPolicy(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAutoScalingPolicy(apiObject *types.AutoScalingPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TargetTrackingScalingPolicyConfiguration; v != nil {
+		tfMap["target_tracking_scaling_policy_configuration"] = []interface{}{flattenTargetTrackingScalingPolicyConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenTargetTrackingScalingPolicyConfiguration(apiObject *types.TargetTrackingScalingPolicyConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"disable_scale_in":   apiObject.DisableScaleIn,
+		"scale_in_cooldown":  apiObject.ScaleInCooldown,
+		"scale_out_cooldown": apiObject.ScaleOutCooldown,
+		"target_value":       apiObject.TargetValue,
+	}
+
+	return tfMap
+}
+
+// flattenReplicaSpecificationSummaries flattens the Region specific settings of the configured Regions.
+// If configured is nil, the settings of all Regions are returned.
+func flattenReplicaSpecificationSummaries(apiObjects []types.ReplicaSpecificationSummary, autoScalingAPIObjects []types.ReplicaAutoScalingSpecification, configured *schema.Set) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	regions := make(map[string]bool)
+	if configured != nil {
+		for _, tfMapRaw := range configured.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				regions[tfMap[names.AttrRegion].(string)] = true
+			}
+		}
+	}
+
+	autoScaling := make(map[string]*types.AutoScalingSettings)
+	for _, apiObject := range autoScalingAPIObjects {
+		if v := apiObject.AutoScalingSpecification; v != nil {
+			autoScaling[aws.ToString(apiObject.Region)] = v.ReadCapacityAutoScaling
+		}
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		region := aws.ToString(apiObject.Region)
+
+		if configured != nil && !regions[region] {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrRegion: region,
+		}
+
+		if v := apiObject.CapacitySpecification; v != nil && v.ReadCapacityUnits != nil {
+			tfMap["read_capacity_units"] = aws.ToInt64(v.ReadCapacityUnits)
+		}
+
+		if v := autoScaling[region]; v != nil && !v.AutoScalingDisabled {
+			tfMap["read_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenCapacitySpecificationSummary(apiObject *types.CapacitySpecificationSummary) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1078,6 +1718,19 @@ func flattenCapacitySpecificationSummary(apiObject *types.CapacitySpecificationS
 	return tfMap
 }
 
+func flattenCdcSpecificationSummary(apiObject *types.CdcSpecificationSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrStatus: apiObject.Status,
+		"view_type":      apiObject.ViewType,
+	}
+
+	return tfMap
+}
+
 func flattenClientSideTimestamps(apiObject *types.ClientSideTimestamps) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyspaces
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/keyspaces"
+	"github.com/aws/aws-sdk-go-v2/service/keyspaces/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_keyspaces_table", name="Table")
+func dataSourceTable() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTableRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_scaling_specification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_auto_scaling":  autoScalingSettingsDataSourceSchema(),
+						"write_capacity_auto_scaling": autoScalingSettingsDataSourceSchema(),
+					},
+				},
+			},
+			"capacity_specification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"throughput_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"write_capacity_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"cdc_specification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"view_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"client_side_timestamps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrComment: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"default_time_to_live": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"encryption_specification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"keyspace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"latest_stream_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"replica_specification": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_auto_scaling": autoScalingSettingsDataSourceSchema(),
+						"read_capacity_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"schema_definition": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"clustering_key": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"order_by": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"column": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"partition_key": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"static_column": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"ttl": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func autoScalingSettingsDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auto_scaling_disabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"maximum_units": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"minimum_units": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"scaling_policy": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"target_tracking_scaling_policy_configuration": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"disable_scale_in": {
+											Type:     schema.TypeBool,
+											Computed: true,
+										},
+										"scale_in_cooldown": {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+										"scale_out_cooldown": {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+										"target_value": {
+											Type:     schema.TypeFloat,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KeyspacesClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	keyspaceName, tableName := d.Get("keyspace_name").(string), d.Get(names.AttrTableName).(string)
+	id := tableCreateResourceID(keyspaceName, tableName)
+	table, err := findTableByTwoPartKey(ctx, conn, keyspaceName, tableName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Keyspaces Table (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrARN, table.ResourceArn)
+
+	var autoScaling *keyspaces.GetTableAutoScalingSettingsOutput
+	if table.CapacitySpecification != nil && table.CapacitySpecification.ThroughputMode == types.ThroughputModeProvisioned {
+		autoScaling, err = findTableAutoScalingSettingsByTwoPartKey(ctx, conn, keyspaceName, tableName)
+
+		// Auto scaling settings require additional application-autoscaling permissions.
+		if errs.IsA[*types.AccessDeniedException](err) {
+			diags = sdkdiag.AppendWarningf(diags, "reading Keyspaces Table (%s) auto scaling settings: %s", d.Id(), err)
+			autoScaling, err = nil, nil
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Keyspaces Table (%s) auto scaling settings: %s", d.Id(), err)
+		}
+	}
+
+	if autoScaling != nil && autoScalingSpecificationEnabled(autoScaling.AutoScalingSpecification) {
+		if err := d.Set("auto_scaling_specification", []interface{}{flattenAutoScalingSpecification(autoScaling.AutoScalingSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting auto_scaling_specification: %s", err)
+		}
+	} else {
+		d.Set("auto_scaling_specification", nil)
+	}
+	if table.CapacitySpecification != nil {
+		if err := d.Set("capacity_specification", []interface{}{flattenCapacitySpecificationSummary(table.CapacitySpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting capacity_specification: %s", err)
+		}
+	} else {
+		d.Set("capacity_specification", nil)
+	}
+	if table.CdcSpecification != nil {
+		if err := d.Set("cdc_specification", []interface{}{flattenCdcSpecificationSummary(table.CdcSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cdc_specification: %s", err)
+		}
+	} else {
+		d.Set("cdc_specification", nil)
+	}
+	if table.ClientSideTimestamps != nil {
+		if err := d.Set("client_side_timestamps", []interface{}{flattenClientSideTimestamps(table.ClientSideTimestamps)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting client_side_timestamps: %s", err)
+		}
+	} else {
+		d.Set("client_side_timestamps", nil)
+	}
+	if table.Comment != nil {
+		if err := d.Set(names.AttrComment, []interface{}{flattenComment(table.Comment)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting comment: %s", err)
+		}
+	} else {
+		d.Set(names.AttrComment, nil)
+	}
+	d.Set("default_time_to_live", table.DefaultTimeToLive)
+	if table.EncryptionSpecification != nil {
+		if err := d.Set("encryption_specification", []interface{}{flattenEncryptionSpecification(table.EncryptionSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting encryption_specification: %s", err)
+		}
+	} else {
+		d.Set("encryption_specification", nil)
+	}
+	d.Set("keyspace_name", table.KeyspaceName)
+	d.Set("latest_stream_arn", table.LatestStreamArn)
+	if table.PointInTimeRecovery != nil {
+		if err := d.Set("point_in_time_recovery", []interface{}{flattenPointInTimeRecoverySummary(table.PointInTimeRecovery)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting point_in_time_recovery: %s", err)
+		}
+	} else {
+		d.Set("point_in_time_recovery", nil)
+	}
+	var replicaAutoScaling []types.ReplicaAutoScalingSpecification
+	if autoScaling != nil {
+		replicaAutoScaling = autoScaling.ReplicaSpecifications
+	}
+	if err := d.Set("replica_specification", flattenReplicaSpecificationSummaries(table.ReplicaSpecifications, replicaAutoScaling, nil)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replica_specification: %s", err)
+	}
+	if table.SchemaDefinition != nil {
+		if err := d.Set("schema_definition", []interface{}{flattenSchemaDefinition(table.SchemaDefinition)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting schema_definition: %s", err)
+		}
+	} else {
+		d.Set("schema_definition", nil)
+	}
+	d.Set(names.AttrTableName, table.TableName)
+	if table.Ttl != nil {
+		if err := d.Set("ttl", []interface{}{flattenTimeToLive(table.Ttl)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ttl: %s", err)
+		}
+	} else {
+		d.Set("ttl", nil)
+	}
+
+	tags, err := listTags(ctx, conn, d.Get(names.AttrARN).(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Keyspaces Table (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyspaces_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKeyspacesTableDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	dataSourceName := "data.aws_keyspaces_table.test"
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableDataSourceConfig_basic(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "capacity_specification.#", resourceName, "capacity_specification.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "capacity_specification.0.throughput_mode", resourceName, "capacity_specification.0.throughput_mode"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_time_to_live", resourceName, "default_time_to_live"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "encryption_specification.#", resourceName, "encryption_specification.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "keyspace_name", resourceName, "keyspace_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_stream_arn", resourceName, "latest_stream_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "point_in_time_recovery.#", resourceName, "point_in_time_recovery.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schema_definition.#", resourceName, "schema_definition.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schema_definition.0.column.#", resourceName, "schema_definition.0.column.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schema_definition.0.partition_key.#", resourceName, "schema_definition.0.partition_key.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTableName, resourceName, names.AttrTableName),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsKey1, resourceName, acctest.CtTagsKey1),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableDataSourceConfig_basic(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_table.test.keyspace_name
+  table_name    = aws_keyspaces_table.test.table_name
+}
+`, rName1, rName2)
+}
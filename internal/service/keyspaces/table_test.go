@@ -178,6 +178,48 @@ func TestAccKeyspacesTable_clientSideTimestamps(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesTable_cdcSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_cdcSpecification(rName1, rName2, "NEW_AND_OLD_IMAGES"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "cdc_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "cdc_specification.0.propagate_tags", "TABLE"),
+					resource.TestCheckResourceAttr(resourceName, "cdc_specification.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "cdc_specification.0.view_type", "NEW_AND_OLD_IMAGES"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_stream_arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cdc_specification.0.propagate_tags"},
+			},
+			{
+				Config: testAccTableConfig_basic(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v2),
+					testAccCheckTableNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "cdc_specification.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesTable_multipleColumns(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v keyspaces.GetTableOutput
@@ -346,6 +388,60 @@ func TestAccKeyspacesTable_update(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesTable_autoScaling(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_autoScaling(rName1, rName2, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.maximum_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.minimum_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.scaling_policy.0.target_tracking_scaling_policy_configuration.0.target_value", "70"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.maximum_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.minimum_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.throughput_mode", "PROVISIONED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_autoScaling(rName1, rName2, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v2),
+					testAccCheckTableNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.scaling_policy.0.target_tracking_scaling_policy_configuration.0.target_value", "50"),
+				),
+			},
+			{
+				Config: testAccTableConfig_provisioned(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v3),
+					testAccCheckTableNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesTable_addColumns(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2 keyspaces.GetTableOutput
@@ -672,6 +768,36 @@ resource "aws_keyspaces_table" "test" {
 `, rName1, rName2)
 }
 
+func testAccTableConfig_cdcSpecification(rName1, rName2, viewType string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  cdc_specification {
+    propagate_tags = "TABLE"
+    status         = "ENABLED"
+    view_type      = %[3]q
+  }
+}
+`, rName1, rName2, viewType)
+}
+
 func testAccTableConfig_multipleColumns(rName1, rName2 string) string {
 	return fmt.Sprintf(`
 resource "aws_keyspaces_keyspace" "test" {
@@ -900,3 +1026,87 @@ resource "aws_keyspaces_table" "test" {
 }
 `, rName1, rName2)
 }
+
+func testAccTableConfig_autoScaling(rName1, rName2 string, targetValue int) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  capacity_specification {
+    read_capacity_units  = 5
+    throughput_mode      = "PROVISIONED"
+    write_capacity_units = 5
+  }
+
+  auto_scaling_specification {
+    read_capacity_auto_scaling {
+      maximum_units = 10
+      minimum_units = 5
+
+      scaling_policy {
+        target_tracking_scaling_policy_configuration {
+          target_value = %[3]d
+        }
+      }
+    }
+
+    write_capacity_auto_scaling {
+      maximum_units = 10
+      minimum_units = 5
+
+      scaling_policy {
+        target_tracking_scaling_policy_configuration {
+          target_value = %[3]d
+        }
+      }
+    }
+  }
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+}
+`, rName1, rName2, targetValue)
+}
+
+func testAccTableConfig_provisioned(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  capacity_specification {
+    read_capacity_units  = 5
+    throughput_mode      = "PROVISIONED"
+    write_capacity_units = 5
+  }
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+}
+`, rName1, rName2)
+}
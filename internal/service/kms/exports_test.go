@@ -12,17 +12,19 @@ var (
 	ResourceGrant              = resourceGrant
 	ResourceKey                = resourceKey
 	ResourceKeyPolicy          = resourceKeyPolicy
+	ResourceKeyPolicyStatement = resourceKeyPolicyStatement
 	ResourceReplicaExternalKey = resourceReplicaExternalKey
 	ResourceReplicaKey         = resourceReplicaKey
 
-	AliasARNToKeyARN          = aliasARNToKeyARN
-	AliasNamePrefix           = aliasNamePrefix
-	FindCustomKeyStoreByID    = findCustomKeyStoreByID
-	FindGrantByTwoPartKey     = findGrantByTwoPartKey
-	FindKeyPolicyByTwoPartKey = findKeyPolicyByTwoPartKey
-	GrantParseResourceID      = grantParseResourceID
-	KeyARNOrIDEqual           = keyARNOrIDEqual
-	PropagationTimeout        = propagationTimeout
-	PolicyNameDefault         = policyNameDefault
-	SecretRemovedMessage      = secretRemovedMessage
+	AliasARNToKeyARN                   = aliasARNToKeyARN
+	AliasNamePrefix                    = aliasNamePrefix
+	FindCustomKeyStoreByID             = findCustomKeyStoreByID
+	FindGrantByTwoPartKey              = findGrantByTwoPartKey
+	FindKeyPolicyByTwoPartKey          = findKeyPolicyByTwoPartKey
+	FindKeyPolicyStatementByTwoPartKey = findKeyPolicyStatementByTwoPartKey
+	GrantParseResourceID               = grantParseResourceID
+	KeyARNOrIDEqual                    = keyARNOrIDEqual
+	PropagationTimeout                 = propagationTimeout
+	PolicyNameDefault                  = policyNameDefault
+	SecretRemovedMessage               = secretRemovedMessage
 )
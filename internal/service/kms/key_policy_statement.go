@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kms_key_policy_statement", name="Key Policy Statement")
+func resourceKeyPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyPolicyStatementCreate,
+		ReadWithoutTimeout:   resourceKeyPolicyStatementRead,
+		UpdateWithoutTimeout: resourceKeyPolicyStatementUpdate,
+		DeleteWithoutTimeout: resourceKeyPolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bypass_policy_lockout_safety_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrKeyID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"statement": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyStatementDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+		},
+	}
+}
+
+func resourceKeyPolicyStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	// key_id may be a key ID or a key ARN. Resolve it so that every statement on the key shares the same lock.
+	key, err := findKeyByID(ctx, conn, d.Get(names.AttrKeyID).(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", d.Get(names.AttrKeyID).(string), err)
+	}
+
+	keyID, sid := aws.ToString(key.KeyId), d.Get("sid").(string)
+
+	mutexKey := keyPolicyMutexKey(keyID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) policy: %s", keyID, err)
+	}
+
+	if _, ok, err := verify.PolicyStatementBySID(aws.ToString(policy), sid); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	} else if ok {
+		return sdkdiag.AppendErrorf(diags, "creating KMS Key Policy Statement: KMS Key (%s) policy statement (%s) already exists", keyID, sid)
+	}
+
+	newPolicy, err := verify.PolicyWithStatement(aws.ToString(policy), sid, d.Get("statement").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := updateKeyPolicy(ctx, conn, "KMS Key Policy Statement", keyID, newPolicy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{keyID, sid}, keyPolicyStatementResourceIDPartCount, false)))
+
+	return append(diags, resourceKeyPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceKeyPolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), keyPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	keyID, sid := parts[0], parts[1]
+
+	statement, err := findKeyPolicyStatementByTwoPartKey(ctx, conn, keyID, sid)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Key Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	statement, err = verify.PolicyStatementToSet(sid, d.Get("statement").(string), statement)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Preserve a configured key ARN; the resource ID always holds the key ID.
+	if d.Get(names.AttrKeyID).(string) == "" {
+		d.Set(names.AttrKeyID, keyID)
+	}
+	d.Set("sid", sid)
+	d.Set("statement", statement)
+
+	return diags
+}
+
+func resourceKeyPolicyStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), keyPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	keyID, sid := parts[0], parts[1]
+
+	mutexKey := keyPolicyMutexKey(keyID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) policy: %s", keyID, err)
+	}
+
+	newPolicy, err := verify.PolicyWithStatement(aws.ToString(policy), sid, d.Get("statement").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := updateKeyPolicy(ctx, conn, "KMS Key Policy Statement", keyID, newPolicy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return append(diags, resourceKeyPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceKeyPolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), keyPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	keyID, sid := parts[0], parts[1]
+
+	mutexKey := keyPolicyMutexKey(keyID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) policy: %s", keyID, err)
+	}
+
+	if _, ok, _ := verify.PolicyStatementBySID(aws.ToString(policy), sid); !ok {
+		return diags
+	}
+
+	newPolicy, n, err := verify.PolicyWithoutStatement(aws.ToString(policy), sid)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// A key policy must contain at least one statement, so restore the default key policy, as aws_kms_key_policy does.
+	if n == 0 {
+		newPolicy = meta.(*conns.AWSClient).DefaultKMSKeyPolicy(ctx)
+	}
+
+	log.Printf("[DEBUG] Deleting KMS Key Policy Statement: %s", d.Id())
+	if err := updateKeyPolicy(ctx, conn, "KMS Key Policy Statement", keyID, newPolicy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+const keyPolicyStatementResourceIDPartCount = 2
+
+func keyPolicyMutexKey(keyID string) string {
+	return "kms_key_policy_" + keyID
+}
+
+func findKeyPolicyStatementByTwoPartKey(ctx context.Context, conn *kms.Client, keyID, sid string) (string, error) {
+	policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+	if err != nil {
+		return "", err
+	}
+
+	statement, ok, err := verify.PolicyStatementBySID(aws.ToString(policy), sid)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", &retry.NotFoundError{}
+	}
+
+	return statement, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSKeyPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_kms_key_policy_statement.test1"
+	resource2Name := "aws_kms_key_policy_statement.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName, "kms:Decrypt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resource1Name),
+					testAccCheckKeyPolicyStatementExists(ctx, resource2Name),
+					resource.TestCheckResourceAttrPair(resource1Name, names.AttrKeyID, "aws_kms_key.test", names.AttrID),
+					resource.TestCheckResourceAttr(resource1Name, "sid", "Decrypt"),
+					resource.TestCheckResourceAttr(resource2Name, "sid", "Encrypt"),
+				),
+			},
+			{
+				ResourceName:            resource1Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bypass_policy_lockout_safety_check"},
+			},
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName, "kms:DescribeKey"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resource1Name),
+					testAccCheckKeyPolicyStatementExists(ctx, resource2Name),
+					resource.TestCheckResourceAttrSet(resource1Name, "statement"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key_policy_statement.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName, "kms:Decrypt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkms.ResourceKeyPolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKeyPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kms_key_policy_statement" {
+				continue
+			}
+
+			_, err := tfkms.FindKeyPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrKeyID], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("KMS Key Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		_, err := tfkms.FindKeyPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrKeyID], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+func testAccKeyPolicyStatementConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key_policy_statement" "test1" {
+  key_id = aws_kms_key.test.id
+  sid    = "Decrypt"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = %[2]q
+    Resource = "*"
+  })
+}
+
+resource "aws_kms_key_policy_statement" "test2" {
+  key_id = aws_kms_key.test.id
+  sid    = "Encrypt"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = ["kms:Encrypt", "kms:GenerateDataKey*"]
+    Resource = "*"
+  })
+}
+`, rName, action)
+}
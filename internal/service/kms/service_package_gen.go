@@ -105,6 +105,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_kms_key_policy",
 			Name:     "Key Policy",
 		},
+		{
+			Factory:  resourceKeyPolicyStatement,
+			TypeName: "aws_kms_key_policy_statement",
+			Name:     "Key Policy Statement",
+		},
 		{
 			Factory:  resourceReplicaExternalKey,
 			TypeName: "aws_kms_replica_external_key",
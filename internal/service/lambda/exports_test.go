@@ -14,9 +14,11 @@ var (
 	ResourceInvocation                   = resourceInvocation
 	ResourceLayerVersion                 = resourceLayerVersion
 	ResourceLayerVersionPermission       = resourceLayerVersionPermission
+	ResourceLayerVersionRetention        = resourceLayerVersionRetention
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
 
+	ExpiredLayerVersions                         = expiredLayerVersions
 	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
 	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
 	FindEventSourceMappingByID                   = findEventSourceMappingByID
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_lambda_layer_version_retention", name="Layer Version Retention")
+func resourceLayerVersionRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLayerVersionRetentionPut,
+		ReadWithoutTimeout:   resourceLayerVersionRetentionRead,
+		UpdateWithoutTimeout: resourceLayerVersionRetentionPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: layerVersionRetentionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"deleted_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"keep_last": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"keep_last", "max_age_in_days"},
+			},
+			"layer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"max_age_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"keep_last", "max_age_in_days"},
+			},
+		},
+	}
+}
+
+func resourceLayerVersionRetentionPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	layerName := d.Get("layer_name").(string)
+	versions, err := findExpiredLayerVersions(ctx, conn, layerName, d.Get("keep_last").(int), d.Get("max_age_in_days").(int))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Layer (%s) Versions: %s", layerName, err)
+	}
+
+	for _, version := range versions {
+		log.Printf("[INFO] Deleting Lambda Layer (%s) Version: %d", layerName, version)
+		_, err := conn.DeleteLayerVersion(ctx, &lambda.DeleteLayerVersionInput{
+			LayerName:     aws.String(layerName),
+			VersionNumber: aws.Int64(version),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Lambda Layer (%s) Version (%d): %s", layerName, version, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(layerName)
+	}
+
+	d.Set("deleted_versions", versions)
+
+	return append(diags, resourceLayerVersionRetentionRead(ctx, d, meta)...)
+}
+
+func resourceLayerVersionRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("layer_name", d.Id())
+
+	return diags
+}
+
+// layerVersionRetentionCustomizeDiff plans an update whenever the retention policy would delete layer versions.
+func layerVersionRetentionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	versions, err := findExpiredLayerVersions(ctx, conn, d.Id(), d.Get("keep_last").(int), d.Get("max_age_in_days").(int))
+
+	if err != nil {
+		return fmt.Errorf("reading Lambda Layer (%s) Versions: %w", d.Id(), err)
+	}
+
+	if len(versions) > 0 {
+		return d.SetNewComputed("deleted_versions")
+	}
+
+	return nil
+}
+
+// findExpiredLayerVersions returns the numbers of the layer's versions that are expired by the retention policy
+// and are not referenced by any function version.
+func findExpiredLayerVersions(ctx context.Context, conn *lambda.Client, layerName string, keepLast, maxAgeInDays int) ([]int64, error) {
+	versions, err := findLayerVersions(ctx, conn, &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return nil, nil
+	}
+
+	referenced, err := findReferencedLayerVersionARNs(ctx, conn)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing Lambda Functions: %w", err)
+	}
+
+	return expiredLayerVersions(versions, referenced, keepLast, maxAgeInDays, time.Now())
+}
+
+// findReferencedLayerVersionARNs returns the ARNs of all layer versions referenced by any function version.
+func findReferencedLayerVersionARNs(ctx context.Context, conn *lambda.Client) (map[string]struct{}, error) {
+	output := make(map[string]struct{})
+
+	input := &lambda.ListFunctionsInput{
+		FunctionVersion: awstypes.FunctionVersionAll,
+	}
+	pages := lambda.NewListFunctionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, function := range page.Functions {
+			for _, layer := range function.Layers {
+				output[aws.ToString(layer.Arn)] = struct{}{}
+			}
+		}
+	}
+
+	return output, nil
+}
+
+const layerVersionCreatedDateFormat = "2006-01-02T15:04:05.000-0700"
+
+// expiredLayerVersions applies the retention policy to the specified layer versions.
+// A version is expired only if it is not one of the newest keepLast versions and is older than maxAgeInDays.
+// A zero keepLast or maxAgeInDays disables that condition. Referenced versions are never expired.
+func expiredLayerVersions(versions []awstypes.LayerVersionsListItem, referenced map[string]struct{}, keepLast, maxAgeInDays int, now time.Time) ([]int64, error) {
+	versions = append([]awstypes.LayerVersionsListItem(nil), versions...)
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version > versions[j].Version
+	})
+
+	cutoff := now.AddDate(0, 0, -maxAgeInDays)
+	expired := make([]int64, 0)
+
+	for i, version := range versions {
+		if keepLast > 0 && i < keepLast {
+			continue
+		}
+
+		if maxAgeInDays > 0 {
+			createdDate, err := time.Parse(layerVersionCreatedDateFormat, aws.ToString(version.CreatedDate))
+			if err != nil {
+				return nil, fmt.Errorf("parsing Lambda Layer Version (%d) created date: %w", version.Version, err)
+			}
+
+			if !createdDate.Before(cutoff) {
+				continue
+			}
+		}
+
+		if _, ok := referenced[aws.ToString(version.LayerVersionArn)]; ok {
+			continue
+		}
+
+		expired = append(expired, version.Version)
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpiredLayerVersions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC)
+	versions := []awstypes.LayerVersionsListItem{
+		{Version: 1, LayerVersionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:layer:test:1"), CreatedDate: aws.String("2024-01-01T00:00:00.000+0000")},
+		{Version: 4, LayerVersionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:layer:test:4"), CreatedDate: aws.String("2024-06-29T00:00:00.000+0000")},
+		{Version: 2, LayerVersionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:layer:test:2"), CreatedDate: aws.String("2024-03-01T00:00:00.000+0000")},
+		{Version: 3, LayerVersionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:layer:test:3"), CreatedDate: aws.String("2024-06-01T00:00:00.000+0000")},
+	}
+
+	testCases := map[string]struct {
+		referenced   map[string]struct{}
+		keepLast     int
+		maxAgeInDays int
+		expected     []int64
+	}{
+		"keep last": {
+			keepLast: 2,
+			expected: []int64{2, 1},
+		},
+		"keep last more than versions": {
+			keepLast: 10,
+			expected: []int64{},
+		},
+		"max age": {
+			maxAgeInDays: 60,
+			expected:     []int64{2, 1},
+		},
+		"keep last and max age": {
+			keepLast:     1,
+			maxAgeInDays: 7,
+			expected:     []int64{3, 2, 1},
+		},
+		"keep last within max age": {
+			keepLast:     3,
+			maxAgeInDays: 7,
+			expected:     []int64{1},
+		},
+		"referenced": {
+			referenced: map[string]struct{}{
+				"arn:aws:lambda:us-west-2:123456789012:layer:test:2": {},
+			},
+			keepLast: 1,
+			expected: []int64{3, 1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tflambda.ExpiredLayerVersions(versions, testCase.referenced, testCase.keepLast, testCase.maxAgeInDays, now)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccLambdaLayerVersionRetention_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_layer_version_retention.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionRetentionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Version 1 is referenced by the function and version 3 is the newest version.
+					resource.TestCheckResourceAttr(resourceName, "deleted_versions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "deleted_versions.0", "aws_lambda_layer_version.test2", names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "keep_last", "1"),
+					resource.TestCheckResourceAttr(resourceName, "layer_name", rName),
+				),
+				// The retention policy deletes a layer version managed by this configuration.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccLayerVersionRetentionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test1" {
+  filename   = "test-fixtures/lambdatest.zip"
+  layer_name = %[1]q
+}
+
+resource "aws_lambda_layer_version" "test2" {
+  filename   = "test-fixtures/lambdatest.zip"
+  layer_name = %[1]q
+
+  depends_on = [aws_lambda_layer_version.test1]
+}
+
+resource "aws_lambda_layer_version" "test3" {
+  filename   = "test-fixtures/lambdatest.zip"
+  layer_name = %[1]q
+
+  depends_on = [aws_lambda_layer_version.test2]
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+  layers        = [aws_lambda_layer_version.test1.arn]
+}
+
+resource "aws_lambda_layer_version_retention" "test" {
+  layer_name = %[1]q
+  keep_last  = 1
+
+  depends_on = [aws_lambda_function.test, aws_lambda_layer_version.test3]
+}
+`, rName))
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaLayerVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resource1Name := "aws_lambda_layer_version.test1"
+	resource2Name := "aws_lambda_layer_version.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.arn", resource2Name, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.compatible_architectures.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.compatible_architectures.0", "arm64"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.created_date", resource2Name, names.AttrCreatedDate),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resource2Name, names.AttrVersion),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.arn", resource1Name, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.1.compatible_runtimes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.1.compatible_runtimes.0", "nodejs16.x"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.version", resource1Name, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionsDataSource_compatibleRuntime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.arn", resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccLayerVersionsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test1" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["nodejs16.x"]
+}
+
+resource "aws_lambda_layer_version" "test2" {
+  filename                 = "test-fixtures/lambdatest.zip"
+  layer_name               = %[1]q
+  compatible_architectures = ["arm64"]
+
+  depends_on = [aws_lambda_layer_version.test1]
+}
+`, rName)
+}
+
+func testAccLayerVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name = aws_lambda_layer_version.test2.layer_name
+}
+`)
+}
+
+func testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name         = aws_lambda_layer_version.test2.layer_name
+  compatible_runtime = "nodejs16.x"
+}
+`)
+}
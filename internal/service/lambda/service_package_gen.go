@@ -64,6 +64,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_lambda_layer_version",
 			Name:     "Layer Version",
 		},
+		{
+			Factory:  dataSourceLayerVersions,
+			TypeName: "aws_lambda_layer_versions",
+			Name:     "Layer Versions",
+		},
 	}
 }
 
@@ -117,6 +122,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_lambda_layer_version_permission",
 			Name:     "Layer Version Permission",
 		},
+		{
+			Factory:  resourceLayerVersionRetention,
+			TypeName: "aws_lambda_layer_version_retention",
+			Name:     "Layer Version Retention",
+		},
 		{
 			Factory:  resourcePermission,
 			TypeName: "aws_lambda_permission",
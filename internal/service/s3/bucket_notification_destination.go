@@ -0,0 +1,622 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_notification_lambda", name="Bucket Notification Lambda")
+func resourceBucketNotificationLambda() *schema.Resource {
+	return resourceBucketNotificationDestination(bucketNotificationDestinationLambda)
+}
+
+// @SDKResource("aws_s3_bucket_notification_queue", name="Bucket Notification Queue")
+func resourceBucketNotificationQueue() *schema.Resource {
+	return resourceBucketNotificationDestination(bucketNotificationDestinationQueue)
+}
+
+// @SDKResource("aws_s3_bucket_notification_topic", name="Bucket Notification Topic")
+func resourceBucketNotificationTopic() *schema.Resource {
+	return resourceBucketNotificationDestination(bucketNotificationDestinationTopic)
+}
+
+// resourceBucketNotificationDestination returns a resource that owns a single destination entry of a bucket's notification configuration.
+// Entries owned by other resources are preserved.
+func resourceBucketNotificationDestination(destination bucketNotificationDestinationType) *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceBucketNotificationDestinationCreate(ctx, d, meta, destination)
+		},
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceBucketNotificationDestinationRead(ctx, d, meta, destination)
+		},
+		UpdateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceBucketNotificationDestinationUpdate(ctx, d, meta, destination)
+		},
+		DeleteWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceBucketNotificationDestinationDelete(ctx, d, meta, destination)
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			destination.arnAttribute(): {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.Event](),
+				},
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"notification_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return bucketNotificationDestinationOverlapCustomizeDiff(ctx, d, meta, destination)
+		},
+	}
+}
+
+func resourceBucketNotificationDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, destination bucketNotificationDestinationType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	notificationID := d.Get("notification_id").(string)
+	if notificationID == "" {
+		notificationID = id.PrefixedUniqueId(destination.idPrefix())
+	}
+	entry := expandBucketNotificationEntry(d, destination, notificationID)
+
+	err := updateBucketNotificationConfiguration(ctx, conn, bucket, func(config *types.NotificationConfiguration) error {
+		for _, v := range allBucketNotificationEntries(config) {
+			if v.id == notificationID {
+				return fmt.Errorf("notification configuration (%s) already exists", notificationID)
+			}
+		}
+
+		destination.setEntries(config, append(destination.entries(config), entry))
+
+		return nil
+	}, func(output *s3.GetBucketNotificationConfigurationOutput) bool {
+		v, ok := findBucketNotificationEntryByID(destination.entries(notificationConfigurationFromOutput(output)), notificationID)
+		return ok && v.equal(entry)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Notification %s: %s", bucket, destination, err)
+	}
+
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{bucket, notificationID}, bucketNotificationDestinationResourceIDPartCount, false)))
+
+	return append(diags, resourceBucketNotificationDestinationRead(ctx, d, meta, destination)...)
+}
+
+func resourceBucketNotificationDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}, destination bucketNotificationDestinationType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), bucketNotificationDestinationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	bucket, notificationID := parts[0], parts[1]
+
+	entry, err := findBucketNotificationEntryByTwoPartKey(ctx, conn, bucket, notificationID, destination)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Notification %s (%s) not found, removing from state", destination, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification %s (%s): %s", destination, d.Id(), err)
+	}
+
+	d.Set(names.AttrBucket, bucket)
+	d.Set(destination.arnAttribute(), entry.arn)
+	d.Set("events", entry.events)
+	d.Set("filter_prefix", entry.filterPrefix)
+	d.Set("filter_suffix", entry.filterSuffix)
+	d.Set("notification_id", notificationID)
+
+	return diags
+}
+
+func resourceBucketNotificationDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, destination bucketNotificationDestinationType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), bucketNotificationDestinationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	bucket, notificationID := parts[0], parts[1]
+	entry := expandBucketNotificationEntry(d, destination, notificationID)
+
+	err = updateBucketNotificationConfiguration(ctx, conn, bucket, func(config *types.NotificationConfiguration) error {
+		entries := destination.entries(config)
+		found := false
+
+		for i, v := range entries {
+			if v.id == notificationID {
+				entries[i] = entry
+				found = true
+			}
+		}
+
+		if !found {
+			entries = append(entries, entry)
+		}
+
+		destination.setEntries(config, entries)
+
+		return nil
+	}, func(output *s3.GetBucketNotificationConfigurationOutput) bool {
+		v, ok := findBucketNotificationEntryByID(destination.entries(notificationConfigurationFromOutput(output)), notificationID)
+		return ok && v.equal(entry)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Notification %s (%s): %s", destination, d.Id(), err)
+	}
+
+	return append(diags, resourceBucketNotificationDestinationRead(ctx, d, meta, destination)...)
+}
+
+func resourceBucketNotificationDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, destination bucketNotificationDestinationType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), bucketNotificationDestinationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	bucket, notificationID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Notification %s: %s", destination, d.Id())
+	err = updateBucketNotificationConfiguration(ctx, conn, bucket, func(config *types.NotificationConfiguration) error {
+		var entries []bucketNotificationEntry
+
+		for _, v := range destination.entries(config) {
+			if v.id != notificationID {
+				entries = append(entries, v)
+			}
+		}
+
+		destination.setEntries(config, entries)
+
+		return nil
+	}, func(output *s3.GetBucketNotificationConfigurationOutput) bool {
+		_, ok := findBucketNotificationEntryByID(destination.entries(notificationConfigurationFromOutput(output)), notificationID)
+		return !ok
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Notification %s (%s): %s", destination, d.Id(), err)
+	}
+
+	return diags
+}
+
+// bucketNotificationDestinationOverlapCustomizeDiff fails the plan if the planned entry overlaps an existing entry owned by another resource.
+// Entries planned by other resources in the same run are not known until they are applied.
+func bucketNotificationDestinationOverlapCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}, destination bucketNotificationDestinationType) error {
+	for _, key := range []string{names.AttrBucket, "events", "filter_prefix", "filter_suffix"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	if !d.HasChanges(names.AttrBucket, "events", "filter_prefix", "filter_suffix") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	bucket := d.Get(names.AttrBucket).(string)
+
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	// The bucket may be created in the same run.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading S3 Bucket (%s) Notification: %w", bucket, err)
+	}
+
+	planned := bucketNotificationEntry{
+		events:       flex.ExpandStringyValueSet[types.Event](d.Get("events").(*schema.Set)),
+		filterPrefix: d.Get("filter_prefix").(string),
+		filterSuffix: d.Get("filter_suffix").(string),
+	}
+
+	// The entry owned by this resource is replaced, so it cannot overlap.
+	notificationID := ""
+	if d.Id() != "" {
+		if parts, err := flex.ExpandResourceId(d.Id(), bucketNotificationDestinationResourceIDPartCount, false); err == nil {
+			notificationID = parts[1]
+		}
+	} else if d.NewValueKnown("notification_id") {
+		notificationID = d.Get("notification_id").(string)
+	}
+
+	for _, v := range allBucketNotificationEntries(notificationConfigurationFromOutput(output)) {
+		if notificationID != "" && v.id == notificationID {
+			continue
+		}
+
+		if bucketNotificationEntriesOverlap(planned, v) {
+			return fmt.Errorf("S3 Bucket (%s) Notification %s overlaps notification configuration (%s): events, filter_prefix and filter_suffix must not all overlap", bucket, destination, v.id)
+		}
+	}
+
+	return nil
+}
+
+const bucketNotificationDestinationResourceIDPartCount = 2
+
+type bucketNotificationDestinationType string
+
+const (
+	bucketNotificationDestinationLambda bucketNotificationDestinationType = "Lambda"
+	bucketNotificationDestinationQueue  bucketNotificationDestinationType = "Queue"
+	bucketNotificationDestinationTopic  bucketNotificationDestinationType = "Topic"
+)
+
+func (t bucketNotificationDestinationType) arnAttribute() string {
+	switch t {
+	case bucketNotificationDestinationLambda:
+		return "lambda_function_arn"
+	case bucketNotificationDestinationQueue:
+		return "queue_arn"
+	default:
+		return names.AttrTopicARN
+	}
+}
+
+// idPrefix matches the prefix used by aws_s3_bucket_notification for generated IDs.
+func (t bucketNotificationDestinationType) idPrefix() string {
+	return fmt.Sprintf("tf-s3-%s-", strings.ToLower(string(t)))
+}
+
+func (t bucketNotificationDestinationType) entries(config *types.NotificationConfiguration) []bucketNotificationEntry {
+	var entries []bucketNotificationEntry
+
+	switch t {
+	case bucketNotificationDestinationLambda:
+		for _, v := range config.LambdaFunctionConfigurations {
+			entries = append(entries, newBucketNotificationEntry(v.Id, v.LambdaFunctionArn, v.Events, v.Filter))
+		}
+	case bucketNotificationDestinationQueue:
+		for _, v := range config.QueueConfigurations {
+			entries = append(entries, newBucketNotificationEntry(v.Id, v.QueueArn, v.Events, v.Filter))
+		}
+	case bucketNotificationDestinationTopic:
+		for _, v := range config.TopicConfigurations {
+			entries = append(entries, newBucketNotificationEntry(v.Id, v.TopicArn, v.Events, v.Filter))
+		}
+	}
+
+	return entries
+}
+
+func (t bucketNotificationDestinationType) setEntries(config *types.NotificationConfiguration, entries []bucketNotificationEntry) {
+	switch t {
+	case bucketNotificationDestinationLambda:
+		config.LambdaFunctionConfigurations = nil
+		for _, v := range entries {
+			config.LambdaFunctionConfigurations = append(config.LambdaFunctionConfigurations, types.LambdaFunctionConfiguration{
+				Events:            v.events,
+				Filter:            v.filter(),
+				Id:                aws.String(v.id),
+				LambdaFunctionArn: aws.String(v.arn),
+			})
+		}
+	case bucketNotificationDestinationQueue:
+		config.QueueConfigurations = nil
+		for _, v := range entries {
+			config.QueueConfigurations = append(config.QueueConfigurations, types.QueueConfiguration{
+				Events:   v.events,
+				Filter:   v.filter(),
+				Id:       aws.String(v.id),
+				QueueArn: aws.String(v.arn),
+			})
+		}
+	case bucketNotificationDestinationTopic:
+		config.TopicConfigurations = nil
+		for _, v := range entries {
+			config.TopicConfigurations = append(config.TopicConfigurations, types.TopicConfiguration{
+				Events:   v.events,
+				Filter:   v.filter(),
+				Id:       aws.String(v.id),
+				TopicArn: aws.String(v.arn),
+			})
+		}
+	}
+}
+
+// bucketNotificationEntry is a destination-independent view of a Lambda function, queue or topic notification configuration.
+type bucketNotificationEntry struct {
+	arn          string
+	events       []types.Event
+	filterPrefix string
+	filterSuffix string
+	id           string
+}
+
+func newBucketNotificationEntry(id, arn *string, events []types.Event, filter *types.NotificationConfigurationFilter) bucketNotificationEntry {
+	entry := bucketNotificationEntry{
+		arn:    aws.ToString(arn),
+		events: events,
+		id:     aws.ToString(id),
+	}
+
+	if filter != nil && filter.Key != nil {
+		for _, v := range filter.Key.FilterRules {
+			switch strings.ToLower(string(v.Name)) {
+			case string(types.FilterRuleNamePrefix):
+				entry.filterPrefix = aws.ToString(v.Value)
+			case string(types.FilterRuleNameSuffix):
+				entry.filterSuffix = aws.ToString(v.Value)
+			}
+		}
+	}
+
+	return entry
+}
+
+func expandBucketNotificationEntry(d *schema.ResourceData, destination bucketNotificationDestinationType, notificationID string) bucketNotificationEntry {
+	return bucketNotificationEntry{
+		arn:          d.Get(destination.arnAttribute()).(string),
+		events:       flex.ExpandStringyValueSet[types.Event](d.Get("events").(*schema.Set)),
+		filterPrefix: d.Get("filter_prefix").(string),
+		filterSuffix: d.Get("filter_suffix").(string),
+		id:           notificationID,
+	}
+}
+
+func (e bucketNotificationEntry) filter() *types.NotificationConfigurationFilter {
+	var filterRules []types.FilterRule
+
+	if e.filterPrefix != "" {
+		filterRules = append(filterRules, types.FilterRule{
+			Name:  types.FilterRuleNamePrefix,
+			Value: aws.String(e.filterPrefix),
+		})
+	}
+
+	if e.filterSuffix != "" {
+		filterRules = append(filterRules, types.FilterRule{
+			Name:  types.FilterRuleNameSuffix,
+			Value: aws.String(e.filterSuffix),
+		})
+	}
+
+	if len(filterRules) == 0 {
+		return nil
+	}
+
+	return &types.NotificationConfigurationFilter{
+		Key: &types.S3KeyFilter{
+			FilterRules: filterRules,
+		},
+	}
+}
+
+func (e bucketNotificationEntry) equal(other bucketNotificationEntry) bool {
+	if e.arn != other.arn || e.filterPrefix != other.filterPrefix || e.filterSuffix != other.filterSuffix || e.id != other.id {
+		return false
+	}
+
+	if len(e.events) != len(other.events) {
+		return false
+	}
+
+	events := make(map[types.Event]bool, len(e.events))
+	for _, v := range e.events {
+		events[v] = true
+	}
+
+	for _, v := range other.events {
+		if !events[v] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bucketNotificationEntriesOverlap returns whether S3 would reject the two entries as overlapping.
+// Entries overlap if they have an event type in common and neither their prefix nor their suffix filters tell them apart.
+func bucketNotificationEntriesOverlap(a, b bucketNotificationEntry) bool {
+	if !bucketNotificationEventsOverlap(a.events, b.events) {
+		return false
+	}
+
+	if !strings.HasPrefix(a.filterPrefix, b.filterPrefix) && !strings.HasPrefix(b.filterPrefix, a.filterPrefix) {
+		return false
+	}
+
+	if !strings.HasSuffix(a.filterSuffix, b.filterSuffix) && !strings.HasSuffix(b.filterSuffix, a.filterSuffix) {
+		return false
+	}
+
+	return true
+}
+
+// bucketNotificationEventsOverlap returns whether any event type in a matches any in b, taking wildcards such as "s3:ObjectCreated:*" into account.
+func bucketNotificationEventsOverlap(a, b []types.Event) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if bucketNotificationEventMatches(string(x), string(y)) || bucketNotificationEventMatches(string(y), string(x)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func bucketNotificationEventMatches(pattern, event string) bool {
+	if v, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(event, v)
+	}
+
+	return pattern == event
+}
+
+func allBucketNotificationEntries(config *types.NotificationConfiguration) []bucketNotificationEntry {
+	var entries []bucketNotificationEntry
+
+	for _, destination := range []bucketNotificationDestinationType{bucketNotificationDestinationLambda, bucketNotificationDestinationQueue, bucketNotificationDestinationTopic} {
+		entries = append(entries, destination.entries(config)...)
+	}
+
+	return entries
+}
+
+func findBucketNotificationEntryByID(entries []bucketNotificationEntry, notificationID string) (bucketNotificationEntry, bool) {
+	for _, v := range entries {
+		if v.id == notificationID {
+			return v, true
+		}
+	}
+
+	return bucketNotificationEntry{}, false
+}
+
+func findBucketNotificationEntryByTwoPartKey(ctx context.Context, conn *s3.Client, bucket, notificationID string, destination bucketNotificationDestinationType) (*bucketNotificationEntry, error) {
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := findBucketNotificationEntryByID(destination.entries(notificationConfigurationFromOutput(output)), notificationID)
+
+	if !ok {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return &entry, nil
+}
+
+func notificationConfigurationFromOutput(output *s3.GetBucketNotificationConfigurationOutput) *types.NotificationConfiguration {
+	return &types.NotificationConfiguration{
+		EventBridgeConfiguration:     output.EventBridgeConfiguration,
+		LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+		QueueConfigurations:          output.QueueConfigurations,
+		TopicConfigurations:          output.TopicConfigurations,
+	}
+}
+
+// updateBucketNotificationConfiguration read-modify-writes the bucket's notification configuration under a per-bucket lock.
+// The lock is held until done reports that the change is visible, so that the next writer does not read a stale configuration.
+func updateBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, bucket string, modify func(*types.NotificationConfiguration) error, done func(*s3.GetBucketNotificationConfigurationOutput) bool) error {
+	mutexKey := "s3_bucket_notification_" + bucket
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketNotificationConfiguration(ctx, conn, bucket, "")
+	})
+
+	if err != nil {
+		return err
+	}
+
+	config := notificationConfigurationFromOutput(outputRaw.(*s3.GetBucketNotificationConfigurationOutput))
+
+	if err := modify(config); err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: config,
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketNotificationConfiguration(ctx, input)
+	}, errCodeNoSuchBucket)
+
+	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "NotificationConfiguration is not valid, expected CreateBucketConfiguration") {
+		err = errDirectoryBucket(err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return tfresource.WaitUntil(ctx, bucketPropagationTimeout, func() (bool, error) {
+		output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+		if err != nil {
+			return false, err
+		}
+
+		return done(output), nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                1 * time.Second,
+	})
+}
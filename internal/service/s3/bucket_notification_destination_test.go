@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketNotificationQueue_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestinationDestroy(ctx, "aws_s3_bucket_notification_queue", tfs3.BucketNotificationDestinationQueue),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationDestinationExists(ctx, resourceName, tfs3.BucketNotificationDestinationQueue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "events.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "tf-acc-test/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ".mp4"),
+					resource.TestMatchResourceAttr(resourceName, "notification_id", regexache.MustCompile(`^tf-s3-queue-`)),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_arn", "aws_sqs_queue.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test-updated/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationDestinationExists(ctx, resourceName, tfs3.BucketNotificationDestinationQueue),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "tf-acc-test-updated/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestinationDestroy(ctx, "aws_s3_bucket_notification_queue", tfs3.BucketNotificationDestinationQueue),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationDestinationExists(ctx, resourceName, tfs3.BucketNotificationDestinationQueue),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketNotificationQueue(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_s3_bucket_notification_queue.test1"
+	resource2Name := "aws_s3_bucket_notification_queue.test2"
+	resource3Name := "aws_s3_bucket_notification_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckBucketNotificationDestinationDestroy(ctx, "aws_s3_bucket_notification_queue", tfs3.BucketNotificationDestinationQueue),
+			testAccCheckBucketNotificationDestinationDestroy(ctx, "aws_s3_bucket_notification_topic", tfs3.BucketNotificationDestinationTopic),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationDestinationExists(ctx, resource1Name, tfs3.BucketNotificationDestinationQueue),
+					testAccCheckBucketNotificationDestinationExists(ctx, resource2Name, tfs3.BucketNotificationDestinationQueue),
+					testAccCheckBucketNotificationDestinationExists(ctx, resource3Name, tfs3.BucketNotificationDestinationTopic),
+					resource.TestCheckResourceAttr(resource1Name, "notification_id", rName+"-1"),
+					resource.TestCheckResourceAttr(resource2Name, "notification_id", rName+"-2"),
+					resource.TestCheckResourceAttr(resource3Name, "notification_id", rName+"-3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_overlap(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestinationDestroy(ctx, "aws_s3_bucket_notification_queue", tfs3.BucketNotificationDestinationQueue),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test/"),
+			},
+			{
+				Config:      testAccBucketNotificationQueueConfig_overlap(rName),
+				ExpectError: regexache.MustCompile(`overlaps notification configuration`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationEventBridge_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_eventbridge.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationEventBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationEventBridgeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationEventBridgeExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					testAccCheckBucketNotificationDestinationExists(ctx, "aws_s3_bucket_notification_queue.test", tfs3.BucketNotificationDestinationQueue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketNotificationDestinationDestroy(ctx context.Context, resourceType string, destination tfs3.BucketNotificationDestinationType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			_, err := tfs3.FindBucketNotificationEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["notification_id"], destination)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Notification %s %s still exists", destination, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationDestinationExists(ctx context.Context, n string, destination tfs3.BucketNotificationDestinationType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindBucketNotificationEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["notification_id"], destination)
+
+		return err
+	}
+}
+
+func testAccCheckBucketNotificationEventBridgeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_notification_eventbridge" {
+				continue
+			}
+
+			err := tfs3.FindBucketNotificationEventBridgeByBucket(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Notification EventBridge %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationEventBridgeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		return tfs3.FindBucketNotificationEventBridgeByBucket(ctx, conn, rs.Primary.ID)
+	}
+}
+
+func testAccBucketNotificationDestinationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "s3.amazonaws.com"
+      },
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s",
+      "Condition": {
+        "ArnEquals": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccBucketNotificationQueueConfig_basic(rName, filterPrefix string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationDestinationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_queue" "test" {
+  bucket    = aws_s3_bucket.test.id
+  queue_arn = aws_sqs_queue.test.arn
+
+  events = [
+    "s3:ObjectCreated:*",
+    "s3:ObjectRemoved:Delete",
+  ]
+
+  filter_prefix = %[1]q
+  filter_suffix = ".mp4"
+}
+`, filterPrefix))
+}
+
+func testAccBucketNotificationQueueConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationDestinationConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "s3.amazonaws.com"
+      },
+      "Action": "SNS:Publish",
+      "Resource": "arn:${data.aws_partition.current.partition}:sns:*:*:%[1]s",
+      "Condition": {
+        "ArnLike": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket_notification_queue" "test1" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = "%[1]s-1"
+  queue_arn       = aws_sqs_queue.test.arn
+  events          = ["s3:ObjectCreated:*"]
+  filter_prefix   = "images/"
+}
+
+resource "aws_s3_bucket_notification_queue" "test2" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = "%[1]s-2"
+  queue_arn       = aws_sqs_queue.test.arn
+  events          = ["s3:ObjectCreated:*"]
+  filter_prefix   = "videos/"
+}
+
+resource "aws_s3_bucket_notification_topic" "test" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = "%[1]s-3"
+  topic_arn       = aws_sns_topic.test.arn
+  events          = ["s3:ObjectRemoved:*"]
+}
+`, rName))
+}
+
+func testAccBucketNotificationQueueConfig_overlap(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test/"), `
+resource "aws_s3_bucket_notification_queue" "overlap" {
+  bucket        = aws_s3_bucket.test.id
+  queue_arn     = aws_sqs_queue.test.arn
+  events        = ["s3:ObjectCreated:Put"]
+  filter_prefix = "tf-acc-test/images/"
+}
+`)
+}
+
+func testAccBucketNotificationEventBridgeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationQueueConfig_basic(rName, "tf-acc-test/"), `
+resource "aws_s3_bucket_notification_eventbridge" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  depends_on = [aws_s3_bucket_notification_queue.test]
+}
+`)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_notification_eventbridge", name="Bucket Notification EventBridge")
+func resourceBucketNotificationEventBridge() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketNotificationEventBridgeCreate,
+		ReadWithoutTimeout:   resourceBucketNotificationEventBridgeRead,
+		DeleteWithoutTimeout: resourceBucketNotificationEventBridgeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceBucketNotificationEventBridgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	err := updateBucketNotificationConfiguration(ctx, conn, bucket, func(config *types.NotificationConfiguration) error {
+		config.EventBridgeConfiguration = &types.EventBridgeConfiguration{}
+
+		return nil
+	}, func(output *s3.GetBucketNotificationConfigurationOutput) bool {
+		return output.EventBridgeConfiguration != nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Notification EventBridge: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return append(diags, resourceBucketNotificationEventBridgeRead(ctx, d, meta)...)
+}
+
+func resourceBucketNotificationEventBridgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	err := findBucketNotificationEventBridgeByBucket(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Notification EventBridge (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification EventBridge (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrBucket, d.Id())
+
+	return diags
+}
+
+func resourceBucketNotificationEventBridgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Notification EventBridge: %s", d.Id())
+	err := updateBucketNotificationConfiguration(ctx, conn, d.Id(), func(config *types.NotificationConfiguration) error {
+		config.EventBridgeConfiguration = nil
+
+		return nil
+	}, func(output *s3.GetBucketNotificationConfigurationOutput) bool {
+		return output.EventBridgeConfiguration == nil
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Notification EventBridge (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findBucketNotificationEventBridgeByBucket(ctx context.Context, conn *s3.Client, bucket string) error {
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	if err != nil {
+		return err
+	}
+
+	if output.EventBridgeConfiguration == nil {
+		return &retry.NotFoundError{}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestBucketNotificationEntriesOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b     bucketNotificationEntry
+		expected bool
+	}{
+		"same events no filters": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreatedPut}},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreatedPut}},
+			expected: true,
+		},
+		"different events": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectRemoved}},
+			expected: false,
+		},
+		"wildcard event": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreatedPut}},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}},
+			expected: true,
+		},
+		"disjoint prefixes": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterPrefix: "images/"},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterPrefix: "videos/"},
+			expected: false,
+		},
+		"nested prefixes": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterPrefix: "images/"},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterPrefix: "images/thumbnails/"},
+			expected: true,
+		},
+		"empty prefix": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterPrefix: "images/"},
+			expected: true,
+		},
+		"disjoint suffixes": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterSuffix: ".jpg"},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterSuffix: ".png"},
+			expected: false,
+		},
+		"nested suffixes": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterSuffix: ".gz"},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterSuffix: ".tar.gz"},
+			expected: true,
+		},
+		"overlapping prefixes disjoint suffixes": {
+			a:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterPrefix: "data/", filterSuffix: ".csv"},
+			b:        bucketNotificationEntry{events: []types.Event{types.EventS3ObjectCreated}, filterPrefix: "data/", filterSuffix: ".json"},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := bucketNotificationEntriesOverlap(testCase.a, testCase.b), testCase.expected; got != want {
+				t.Errorf("bucketNotificationEntriesOverlap() = %t, want %t", got, want)
+			}
+
+			if got, want := bucketNotificationEntriesOverlap(testCase.b, testCase.a), testCase.expected; got != want {
+				t.Errorf("bucketNotificationEntriesOverlap() reversed = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_policy_statement", name="Bucket Policy Statement")
+func resourceBucketPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketPolicyStatementCreate,
+		ReadWithoutTimeout:   resourceBucketPolicyStatementRead,
+		UpdateWithoutTimeout: resourceBucketPolicyStatementUpdate,
+		DeleteWithoutTimeout: resourceBucketPolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"statement": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyStatementDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+		},
+	}
+}
+
+func resourceBucketPolicyStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, sid := d.Get(names.AttrBucket).(string), d.Get("sid").(string)
+	conn := bucketPolicyStatementConn(ctx, meta, bucket)
+	statement := d.Get("statement").(string)
+
+	mutexKey := "s3_bucket_policy_" + bucket
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findBucketPolicy(ctx, conn, bucket)
+
+	switch {
+	case tfresource.NotFound(err):
+		policy = ""
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Policy: %s", bucket, err)
+	}
+
+	if _, ok, err := verify.PolicyStatementBySID(policy, sid); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	} else if ok {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Policy Statement: S3 Bucket (%s) Policy statement (%s) already exists", bucket, sid)
+	}
+
+	policy, err = verify.PolicyWithStatement(policy, sid, statement)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(policy),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketPolicy(ctx, input)
+	}, errCodeMalformedPolicy, errCodeNoSuchBucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting S3 Bucket (%s) Policy Statement (%s): %s", bucket, sid, err)
+	}
+
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{bucket, sid}, bucketPolicyStatementResourceIDPartCount, false)))
+
+	if err := waitBucketPolicyStatementPropagated(ctx, conn, bucket, sid, statement); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Policy Statement (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceBucketPolicyStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, sid := d.Get(names.AttrBucket).(string), d.Get("sid").(string)
+	conn := bucketPolicyStatementConn(ctx, meta, bucket)
+	statement := d.Get("statement").(string)
+
+	mutexKey := "s3_bucket_policy_" + bucket
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findBucketPolicy(ctx, conn, bucket)
+
+	switch {
+	case tfresource.NotFound(err):
+		policy = ""
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Policy: %s", bucket, err)
+	}
+
+	policy, err = verify.PolicyWithStatement(policy, sid, statement)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(policy),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketPolicy(ctx, input)
+	}, errCodeMalformedPolicy, errCodeNoSuchBucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting S3 Bucket (%s) Policy Statement (%s): %s", bucket, sid, err)
+	}
+
+	if err := waitBucketPolicyStatementPropagated(ctx, conn, bucket, sid, statement); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Policy Statement (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceBucketPolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parts, err := flex.ExpandResourceId(d.Id(), bucketPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	bucket, sid := parts[0], parts[1]
+	conn := bucketPolicyStatementConn(ctx, meta, bucket)
+
+	statement, err := findBucketPolicyStatementByTwoPartKey(ctx, conn, bucket, sid)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	statement, err = verify.PolicyStatementToSet(sid, d.Get("statement").(string), statement)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrBucket, bucket)
+	d.Set("sid", sid)
+	d.Set("statement", statement)
+
+	return diags
+}
+
+func resourceBucketPolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parts, err := flex.ExpandResourceId(d.Id(), bucketPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	bucket, sid := parts[0], parts[1]
+	conn := bucketPolicyStatementConn(ctx, meta, bucket)
+
+	mutexKey := "s3_bucket_policy_" + bucket
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findBucketPolicy(ctx, conn, bucket)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Policy: %s", bucket, err)
+	}
+
+	policy, n, err := verify.PolicyWithoutStatement(policy, sid)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Policy Statement: %s", d.Id())
+	if n == 0 {
+		// A bucket policy must contain at least one statement.
+		_, err = conn.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{
+			Bucket: aws.String(bucket),
+		})
+	} else {
+		_, err = conn.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+			Bucket: aws.String(bucket),
+			Policy: aws.String(policy),
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketPolicyStatementByTwoPartKey(ctx, conn, bucket, sid)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Policy Statement (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const bucketPolicyStatementResourceIDPartCount = 2
+
+func bucketPolicyStatementConn(ctx context.Context, meta interface{}, bucket string) *s3.Client {
+	if isDirectoryBucket(bucket) {
+		return meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	return meta.(*conns.AWSClient).S3Client(ctx)
+}
+
+func findBucketPolicyStatementByTwoPartKey(ctx context.Context, conn *s3.Client, bucket, sid string) (string, error) {
+	policy, err := findBucketPolicy(ctx, conn, bucket)
+
+	if err != nil {
+		return "", err
+	}
+
+	statement, ok, err := verify.PolicyStatementBySID(policy, sid)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", &retry.NotFoundError{}
+	}
+
+	return statement, nil
+}
+
+func waitBucketPolicyStatementPropagated(ctx context.Context, conn *s3.Client, bucket, sid, statement string) error {
+	return tfresource.WaitUntil(ctx, bucketPropagationTimeout, func() (bool, error) {
+		output, err := findBucketPolicyStatementByTwoPartKey(ctx, conn, bucket, sid)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return verify.PolicyStatementsEquivalent(sid, output, statement), nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                1 * time.Second,
+	})
+}
@@ -15,10 +15,15 @@ var (
 	ResourceBucketLogging                           = resourceBucketLogging
 	ResourceBucketMetric                            = resourceBucketMetric
 	ResourceBucketNotification                      = resourceBucketNotification
+	ResourceBucketNotificationEventBridge           = resourceBucketNotificationEventBridge
+	ResourceBucketNotificationLambda                = resourceBucketNotificationLambda
+	ResourceBucketNotificationQueue                 = resourceBucketNotificationQueue
+	ResourceBucketNotificationTopic                 = resourceBucketNotificationTopic
 	ResourceBucketObjectLockConfiguration           = resourceBucketObjectLockConfiguration
 	ResourceBucketObject                            = resourceBucketObject
 	ResourceBucketOwnershipControls                 = resourceBucketOwnershipControls
 	ResourceBucketPolicy                            = resourceBucketPolicy
+	ResourceBucketPolicyStatement                   = resourceBucketPolicyStatement
 	ResourceBucketPublicAccessBlock                 = resourceBucketPublicAccessBlock
 	ResourceBucketReplicationConfiguration          = resourceBucketReplicationConfiguration
 	ResourceBucketRequestPaymentConfiguration       = resourceBucketRequestPaymentConfiguration
@@ -28,44 +33,51 @@ var (
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
-	BucketUpdateTags                      = bucketUpdateTags
-	BucketRegionalDomainName              = bucketRegionalDomainName
-	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
-	DeleteAllObjectVersions               = deleteAllObjectVersions
-	EmptyBucket                           = emptyBucket
-	FindAnalyticsConfiguration            = findAnalyticsConfiguration
-	FindBucket                            = findBucket
-	FindBucketACL                         = findBucketACL
-	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
-	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
-	FindBucketPolicy                      = findBucketPolicy
-	FindBucketRequestPayment              = findBucketRequestPayment
-	FindBucketVersioning                  = findBucketVersioning
-	FindBucketWebsite                     = findBucketWebsite
-	FindCORSRules                         = findCORSRules
-	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
-	FindInventoryConfiguration            = findInventoryConfiguration
-	FindLifecycleRules                    = findLifecycleRules
-	FindLoggingEnabled                    = findLoggingEnabled
-	FindMetricsConfiguration              = findMetricsConfiguration
-	FindObjectByBucketAndKey              = findObjectByBucketAndKey
-	FindObjectLockConfiguration           = findObjectLockConfiguration
-	FindOwnershipControls                 = findOwnershipControls
-	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
-	FindReplicationConfiguration          = findReplicationConfiguration
-	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
-	HostedZoneIDForRegion                 = hostedZoneIDForRegion
-	IsDirectoryBucket                     = isDirectoryBucket
-	ObjectListTags                        = objectListTags
-	ObjectUpdateTags                      = objectUpdateTags
-	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
-	ValidBucketName                       = validBucketName
+	BucketUpdateTags                          = bucketUpdateTags
+	BucketRegionalDomainName                  = bucketRegionalDomainName
+	BucketWebsiteEndpointAndDomain            = bucketWebsiteEndpointAndDomain
+	DeleteAllObjectVersions                   = deleteAllObjectVersions
+	EmptyBucket                               = emptyBucket
+	FindAnalyticsConfiguration                = findAnalyticsConfiguration
+	FindBucket                                = findBucket
+	FindBucketACL                             = findBucketACL
+	FindBucketAccelerateConfiguration         = findBucketAccelerateConfiguration
+	FindBucketNotificationConfiguration       = findBucketNotificationConfiguration
+	FindBucketNotificationEntryByTwoPartKey   = findBucketNotificationEntryByTwoPartKey
+	FindBucketNotificationEventBridgeByBucket = findBucketNotificationEventBridgeByBucket
+	FindBucketPolicy                          = findBucketPolicy
+	FindBucketPolicyStatementByTwoPartKey     = findBucketPolicyStatementByTwoPartKey
+	FindBucketRequestPayment                  = findBucketRequestPayment
+	FindBucketVersioning                      = findBucketVersioning
+	FindBucketWebsite                         = findBucketWebsite
+	FindCORSRules                             = findCORSRules
+	FindIntelligentTieringConfiguration       = findIntelligentTieringConfiguration
+	FindInventoryConfiguration                = findInventoryConfiguration
+	FindLifecycleRules                        = findLifecycleRules
+	FindLoggingEnabled                        = findLoggingEnabled
+	FindMetricsConfiguration                  = findMetricsConfiguration
+	FindObjectByBucketAndKey                  = findObjectByBucketAndKey
+	FindObjectLockConfiguration               = findObjectLockConfiguration
+	FindOwnershipControls                     = findOwnershipControls
+	FindPublicAccessBlockConfiguration        = findPublicAccessBlockConfiguration
+	FindReplicationConfiguration              = findReplicationConfiguration
+	FindServerSideEncryptionConfiguration     = findServerSideEncryptionConfiguration
+	HostedZoneIDForRegion                     = hostedZoneIDForRegion
+	IsDirectoryBucket                         = isDirectoryBucket
+	ObjectListTags                            = objectListTags
+	ObjectUpdateTags                          = objectUpdateTags
+	SDKv1CompatibleCleanKey                   = sdkv1CompatibleCleanKey
+	ValidBucketName                           = validBucketName
 
-	BucketPropagationTimeout       = bucketPropagationTimeout
-	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
-	ErrCodeBucketAlreadyExists     = errCodeBucketAlreadyExists
-	ErrCodeBucketAlreadyOwnedByYou = errCodeBucketAlreadyOwnedByYou
-	ErrCodeNoSuchCORSConfiguration = errCodeNoSuchCORSConfiguration
-	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
-	LifecycleRuleStatusEnabled     = lifecycleRuleStatusEnabled
+	BucketNotificationDestinationQueue = bucketNotificationDestinationQueue
+	BucketNotificationDestinationTopic = bucketNotificationDestinationTopic
+	BucketPropagationTimeout           = bucketPropagationTimeout
+	BucketVersioningStatusDisabled     = bucketVersioningStatusDisabled
+	ErrCodeBucketAlreadyExists         = errCodeBucketAlreadyExists
+	ErrCodeBucketAlreadyOwnedByYou     = errCodeBucketAlreadyOwnedByYou
+	ErrCodeNoSuchCORSConfiguration     = errCodeNoSuchCORSConfiguration
+	LifecycleRuleStatusDisabled        = lifecycleRuleStatusDisabled
+	LifecycleRuleStatusEnabled         = lifecycleRuleStatusEnabled
 )
+
+type BucketNotificationDestinationType = bucketNotificationDestinationType
@@ -139,6 +139,26 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_notification",
 			Name:     "Bucket Notification",
 		},
+		{
+			Factory:  resourceBucketNotificationEventBridge,
+			TypeName: "aws_s3_bucket_notification_eventbridge",
+			Name:     "Bucket Notification EventBridge",
+		},
+		{
+			Factory:  resourceBucketNotificationLambda,
+			TypeName: "aws_s3_bucket_notification_lambda",
+			Name:     "Bucket Notification Lambda",
+		},
+		{
+			Factory:  resourceBucketNotificationQueue,
+			TypeName: "aws_s3_bucket_notification_queue",
+			Name:     "Bucket Notification Queue",
+		},
+		{
+			Factory:  resourceBucketNotificationTopic,
+			TypeName: "aws_s3_bucket_notification_topic",
+			Name:     "Bucket Notification Topic",
+		},
 		{
 			Factory:  resourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
@@ -163,6 +183,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_policy",
 			Name:     "Bucket Policy",
 		},
+		{
+			Factory:  resourceBucketPolicyStatement,
+			TypeName: "aws_s3_bucket_policy_statement",
+			Name:     "Bucket Policy Statement",
+		},
 		{
 			Factory:  resourceBucketPublicAccessBlock,
 			TypeName: "aws_s3_bucket_public_access_block",
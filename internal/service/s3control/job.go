@@ -0,0 +1,1432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_job", name="Job")
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		CustomizeDiff: resourceJobCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"manifest", "manifest_generator"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrLocation: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFormat: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.JobManifestFormat](),
+									},
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.JobManifestFieldName](),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"manifest_generator": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_manifest_output": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						names.AttrExpectedBucketOwner: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						names.AttrFilter: {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"created_after": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"created_before": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"eligible_for_replication": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"key_name_constraint": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"match_any_prefix": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"match_any_substring": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"match_any_suffix": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"match_any_storage_class": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+										},
+									},
+									"object_replication_statuses": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.ReplicationStatus](),
+										},
+									},
+									"object_size_greater_than_bytes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than_bytes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"manifest_output_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucket: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"expected_manifest_bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"manifest_encryption": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sse_kms": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrKeyID: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+														},
+													},
+												},
+												"sse_s3": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{},
+													},
+												},
+											},
+										},
+									},
+									"manifest_format": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.GeneratedManifestFormat](),
+									},
+									"manifest_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"source_bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFunctionARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"invocation_schema_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"1.0", "2.0"}, false),
+									},
+									"user_arguments": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"s3_delete_object_tagging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"glacier_job_tier": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3GlacierJobTier](),
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3CannedAccessControlList](),
+									},
+									"checksum_algorithm": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ChecksumAlgorithm](),
+									},
+									"metadata_directive": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3MetadataDirective](),
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"object_lock_legal_hold_status": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockLegalHoldStatus](),
+									},
+									"object_lock_mode": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockMode](),
+									},
+									"object_lock_retain_until_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrStorageClass: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_put_object_legal_hold": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrStatus: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockLegalHoldStatus](),
+									},
+								},
+							},
+						},
+						"s3_put_object_retention": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bypass_governance_retention": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									names.AttrMode: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockRetentionMode](),
+									},
+									"retain_until_date": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"s3_replicate_object": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+			names.AttrPriority: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, math.MaxInt32),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrFormat: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportFormat](),
+						},
+						names.AttrPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportScope](),
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_failure_threshold_percentage": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+			},
+			"termination_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAccountID); ok {
+		accountID = v.(string)
+	}
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+		RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_generator"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManifestGenerator = &types.JobManifestGeneratorMemberS3JobManifestGenerator{
+			Value: expandS3JobManifestGenerator(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Batch Operations Job: %s", err)
+	}
+
+	jobID := aws.ToString(output.JobId)
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{accountID, jobID}, jobResourceIDPartCount, false)))
+
+	// A job that requires confirmation waits, suspended, until it is confirmed outside of Terraform.
+	if d.Get("confirmation_required").(bool) {
+		if _, err := waitJobAwaitingConfirmation(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) create: %s", d.Id(), err)
+		}
+
+		return append(diags, resourceJobRead(ctx, d, meta)...)
+	}
+
+	job, err := waitJobComplete(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) complete: %s", d.Id(), err)
+
+		return append(diags, resourceJobRead(ctx, d, meta)...)
+	}
+
+	if v, ok := d.GetOk("task_failure_threshold_percentage"); ok {
+		if err := checkJobTaskFailureThreshold(job.ProgressSummary, v.(float64)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "S3 Batch Operations Job (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+// resourceJobCustomizeDiff rejects task_failure_threshold_percentage for jobs that require confirmation.
+// Terraform stops waiting for such a job before it runs, so the threshold could never be checked.
+func resourceJobCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("confirmation_required").(bool) && !d.GetRawConfig().GetAttr("task_failure_threshold_percentage").IsNull() {
+		return errors.New(`"task_failure_threshold_percentage" cannot be specified when "confirmation_required" is true`)
+	}
+
+	return nil
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	accountID, jobID := parts[0], parts[1]
+
+	job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	// Jobs are only described for 90 days after they finish. Keep the recorded result rather than running the job again.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, keeping recorded result", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrAccountID, accountID)
+	d.Set(names.AttrARN, job.JobArn)
+	if job.CreationTime != nil {
+		d.Set(names.AttrCreationTime, aws.ToTime(job.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationTime, nil)
+	}
+	if err := d.Set("failure_reasons", flattenJobFailures(job.FailureReasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting failure_reasons: %s", err)
+	}
+	d.Set("job_id", job.JobId)
+	if err := d.Set("progress_summary", flattenJobProgressSummary(job.ProgressSummary)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting progress_summary: %s", err)
+	}
+	d.Set(names.AttrStatus, job.Status)
+	d.Set("status_update_reason", job.StatusUpdateReason)
+	if job.TerminationDate != nil {
+		d.Set("termination_date", aws.ToTime(job.TerminationDate).Format(time.RFC3339))
+	} else {
+		d.Set("termination_date", nil)
+	}
+
+	return diags
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	accountID, jobID := parts[0], parts[1]
+
+	job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	// S3 Batch Operations Jobs cannot be deleted. Cancel a job that has not finished.
+	switch job.Status {
+	case types.JobStatusCancelled, types.JobStatusComplete, types.JobStatusFailed:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	_, err = conn.UpdateJobStatus(ctx, &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: types.RequestedJobStatusCancelled,
+		StatusUpdateReason: aws.String("Cancelled by Terraform"),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobCancelled(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const jobResourceIDPartCount = 2
+
+// checkJobTaskFailureThreshold returns an error if the percentage of failed tasks is above threshold.
+func checkJobTaskFailureThreshold(apiObject *types.JobProgressSummary, threshold float64) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	total, failed := aws.ToInt64(apiObject.TotalNumberOfTasks), aws.ToInt64(apiObject.NumberOfTasksFailed)
+
+	if total == 0 {
+		return nil
+	}
+
+	if rate := float64(failed) / float64(total) * 100; rate > threshold {
+		return fmt.Errorf("%d of %d tasks failed (%.2f%%), above the threshold of %.2f%%", failed, total, rate, threshold)
+	}
+
+	return nil
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*types.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func statusJob(ctx context.Context, conn *s3control.Client, accountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitJobAwaitingConfirmation(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.JobStatusNew, types.JobStatusPreparing),
+		Target:  enum.Slice(types.JobStatusSuspended),
+		Refresh: statusJob(ctx, conn, accountID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		tfresource.SetLastError(err, jobFailuresError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobComplete(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			types.JobStatusActive,
+			types.JobStatusCompleting,
+			types.JobStatusFailing,
+			types.JobStatusNew,
+			types.JobStatusPaused,
+			types.JobStatusPausing,
+			types.JobStatusPreparing,
+			types.JobStatusReady,
+		),
+		Target:  enum.Slice(types.JobStatusComplete),
+		Refresh: statusJob(ctx, conn, accountID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		if output.Status == types.JobStatusSuspended {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.SuspendedCause)))
+		} else {
+			tfresource.SetLastError(err, jobFailuresError(output.FailureReasons))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCancelled(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			types.JobStatusActive,
+			types.JobStatusCancelling,
+			types.JobStatusNew,
+			types.JobStatusPaused,
+			types.JobStatusPausing,
+			types.JobStatusPreparing,
+			types.JobStatusReady,
+			types.JobStatusSuspended,
+		),
+		Target:  enum.Slice(types.JobStatusCancelled, types.JobStatusComplete, types.JobStatusFailed),
+		Refresh: statusJob(ctx, conn, accountID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func jobFailuresError(apiObjects []types.JobFailure) error {
+	var failures []error
+
+	for _, apiObject := range apiObjects {
+		failures = append(failures, fmt.Errorf("%s: %s", aws.ToString(apiObject.FailureCode), aws.ToString(apiObject.FailureReason)))
+	}
+
+	return errors.Join(failures...)
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *types.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifest{}
+
+	if v, ok := tfMap[names.AttrLocation].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandJobManifestLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Spec = expandJobManifestSpec(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJobManifestLocation(tfMap map[string]interface{}) *types.JobManifestLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestLocation{}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+		apiObject.ObjectArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+		apiObject.ObjectVersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestSpec(tfMap map[string]interface{}) *types.JobManifestSpec {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestSpec{}
+
+	if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Fields = flex.ExpandStringyValueList[types.JobManifestFieldName](v)
+	}
+
+	if v, ok := tfMap[names.AttrFormat].(string); ok && v != "" {
+		apiObject.Format = types.JobManifestFormat(v)
+	}
+
+	return apiObject
+}
+
+func expandS3JobManifestGenerator(tfMap map[string]interface{}) types.S3JobManifestGenerator {
+	apiObject := types.S3JobManifestGenerator{}
+
+	if v, ok := tfMap["enable_manifest_output"].(bool); ok {
+		apiObject.EnableManifestOutput = v
+	}
+
+	if v, ok := tfMap[names.AttrExpectedBucketOwner].(string); ok && v != "" {
+		apiObject.ExpectedBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandJobManifestGeneratorFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_output_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestOutputLocation = expandS3ManifestOutputLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_bucket"].(string); ok && v != "" {
+		apiObject.SourceBucket = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestGeneratorFilter(tfMap map[string]interface{}) *types.JobManifestGeneratorFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestGeneratorFilter{}
+
+	if v, ok := tfMap["created_after"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedAfter = aws.Time(v)
+	}
+
+	if v, ok := tfMap["created_before"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedBefore = aws.Time(v)
+	}
+
+	if v, ok := tfMap["eligible_for_replication"].(bool); ok && v {
+		apiObject.EligibleForReplication = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["key_name_constraint"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KeyNameConstraint = expandKeyNameConstraint(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["match_any_storage_class"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyStorageClass = flex.ExpandStringyValueSet[types.S3StorageClass](v)
+	}
+
+	if v, ok := tfMap["object_replication_statuses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ObjectReplicationStatuses = flex.ExpandStringyValueSet[types.ReplicationStatus](v)
+	}
+
+	if v, ok := tfMap["object_size_greater_than_bytes"].(int); ok && v > 0 {
+		apiObject.ObjectSizeGreaterThanBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than_bytes"].(int); ok && v > 0 {
+		apiObject.ObjectSizeLessThanBytes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandKeyNameConstraint(tfMap map[string]interface{}) *types.KeyNameConstraint {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.KeyNameConstraint{}
+
+	if v, ok := tfMap["match_any_prefix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyPrefix = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_substring"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnySubstring = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_suffix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnySuffix = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ManifestOutputLocation(tfMap map[string]interface{}) *types.S3ManifestOutputLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ManifestOutputLocation{}
+
+	if v, ok := tfMap[names.AttrBucket].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["expected_manifest_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedManifestBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestEncryption = expandGeneratedManifestEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_format"].(string); ok && v != "" {
+		apiObject.ManifestFormat = types.GeneratedManifestFormat(v)
+	}
+
+	if v, ok := tfMap["manifest_prefix"].(string); ok && v != "" {
+		apiObject.ManifestPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGeneratedManifestEncryption(tfMap map[string]interface{}) *types.GeneratedManifestEncryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.GeneratedManifestEncryption{}
+
+	if v, ok := tfMap["sse_kms"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SSEKMS = &types.SSEKMSEncryption{
+			KeyId: aws.String(v[0].(map[string]interface{})[names.AttrKeyID].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sse_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SSES3 = &types.SSES3Encryption{}
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(tfMap map[string]interface{}) *types.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LambdaInvoke = expandLambdaInvokeOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_delete_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3DeleteObjectTagging = &types.S3DeleteObjectTaggingOperation{}
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3InitiateRestoreObject = expandS3InitiateRestoreObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_legal_hold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectLegalHold = &types.S3SetObjectLegalHoldOperation{
+			LegalHold: &types.S3ObjectLockLegalHold{
+				Status: types.S3ObjectLockLegalHoldStatus(v[0].(map[string]interface{})[names.AttrStatus].(string)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["s3_put_object_retention"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectRetention = expandS3SetObjectRetentionOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3PutObjectTagging = &types.S3SetObjectTaggingOperation{}
+
+		if v[0] != nil {
+			apiObject.S3PutObjectTagging.TagSet = expandS3Tags(v[0].(map[string]interface{})["tag_set"].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := tfMap["s3_replicate_object"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3ReplicateObject = &types.S3ReplicateObjectOperation{}
+	}
+
+	return apiObject
+}
+
+func expandLambdaInvokeOperation(tfMap map[string]interface{}) *types.LambdaInvokeOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LambdaInvokeOperation{}
+
+	if v, ok := tfMap[names.AttrFunctionARN].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["invocation_schema_version"].(string); ok && v != "" {
+		apiObject.InvocationSchemaVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_arguments"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserArguments = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3InitiateRestoreObjectOperation(tfMap map[string]interface{}) *types.S3InitiateRestoreObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3InitiateRestoreObjectOperation{}
+
+	if v, ok := tfMap["expiration_in_days"].(int); ok && v != 0 {
+		apiObject.ExpirationInDays = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+		apiObject.GlacierJobTier = types.S3GlacierJobTier(v)
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(tfMap map[string]interface{}) *types.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok {
+		apiObject.BucketKeyEnabled = v
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = types.S3CannedAccessControlList(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = types.S3ChecksumAlgorithm(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = types.S3MetadataDirective(v)
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.NewObjectTagging = expandS3Tags(v)
+	}
+
+	if v, ok := tfMap["object_lock_legal_hold_status"].(string); ok && v != "" {
+		apiObject.ObjectLockLegalHoldStatus = types.S3ObjectLockLegalHoldStatus(v)
+	}
+
+	if v, ok := tfMap["object_lock_mode"].(string); ok && v != "" {
+		apiObject.ObjectLockMode = types.S3ObjectLockMode(v)
+	}
+
+	if v, ok := tfMap["object_lock_retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ObjectLockRetainUntilDate = aws.Time(v)
+	}
+
+	if v, ok := tfMap["requester_pays"].(bool); ok {
+		apiObject.RequesterPays = v
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrStorageClass].(string); ok && v != "" {
+		apiObject.StorageClass = types.S3StorageClass(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectRetentionOperation(tfMap map[string]interface{}) *types.S3SetObjectRetentionOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3SetObjectRetentionOperation{
+		Retention: &types.S3Retention{},
+	}
+
+	if v, ok := tfMap["bypass_governance_retention"].(bool); ok && v {
+		apiObject.BypassGovernanceRetention = aws.Bool(v)
+	}
+
+	if v, ok := tfMap[names.AttrMode].(string); ok && v != "" {
+		apiObject.Retention.Mode = types.S3ObjectLockRetentionMode(v)
+	}
+
+	if v, ok := tfMap["retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.Retention.RetainUntilDate = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Tags(tfMap map[string]interface{}) []types.S3Tag {
+	apiObjects := make([]types.S3Tag, 0, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, types.S3Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandJobReport(tfMap map[string]interface{}) *types.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobReport{}
+
+	if v, ok := tfMap[names.AttrBucket].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = v
+	}
+
+	if v, ok := tfMap[names.AttrFormat].(string); ok && v != "" {
+		apiObject.Format = types.JobReportFormat(v)
+	}
+
+	if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = types.JobReportScope(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobFailures(apiObjects []types.JobFailure) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"failure_code":   aws.ToString(apiObject.FailureCode),
+			"failure_reason": aws.ToString(apiObject.FailureReason),
+		})
+	}
+
+	return tfList
+}
+
+func flattenJobProgressSummary(apiObject *types.JobProgressSummary) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"number_of_tasks_failed":    aws.ToInt64(apiObject.NumberOfTasksFailed),
+		"number_of_tasks_succeeded": aws.ToInt64(apiObject.NumberOfTasksSucceeded),
+		"total_number_of_tasks":     aws.ToInt64(apiObject.TotalNumberOfTasks),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAccountID),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "s3", regexache.MustCompile(`job/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_failed", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusComplete)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_manifest(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_manifest(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusComplete)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_confirmationRequired(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobCancelled(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_confirmationRequired(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusSuspended)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_confirmationRequiredTaskFailureThreshold(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobCancelled(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_confirmationRequiredTaskFailureThreshold(rName),
+				ExpectError: regexache.MustCompile(`"task_failure_threshold_percentage" cannot be specified when "confirmation_required" is true`),
+			},
+		},
+	})
+}
+
+func TestCheckJobTaskFailureThreshold(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		summary     *types.JobProgressSummary
+		threshold   float64
+		expectError bool
+	}{
+		"no summary": {
+			threshold: 0,
+		},
+		"no tasks": {
+			summary: &types.JobProgressSummary{
+				NumberOfTasksFailed: aws.Int64(0),
+				TotalNumberOfTasks:  aws.Int64(0),
+			},
+			threshold: 0,
+		},
+		"no failures": {
+			summary: &types.JobProgressSummary{
+				NumberOfTasksFailed: aws.Int64(0),
+				TotalNumberOfTasks:  aws.Int64(100),
+			},
+			threshold: 0,
+		},
+		"at threshold": {
+			summary: &types.JobProgressSummary{
+				NumberOfTasksFailed: aws.Int64(5),
+				TotalNumberOfTasks:  aws.Int64(100),
+			},
+			threshold: 5,
+		},
+		"above threshold": {
+			summary: &types.JobProgressSummary{
+				NumberOfTasksFailed: aws.Int64(6),
+				TotalNumberOfTasks:  aws.Int64(100),
+			},
+			threshold:   5,
+			expectError: true,
+		},
+		"any failure": {
+			summary: &types.JobProgressSummary{
+				NumberOfTasksFailed: aws.Int64(1),
+				TotalNumberOfTasks:  aws.Int64(1000),
+			},
+			threshold:   0,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3control.CheckJobTaskFailureThreshold(testCase.summary, testCase.threshold)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("CheckJobTaskFailureThreshold() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func testAccCheckJobExists(ctx context.Context, n string, v *types.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		output, err := tfs3control.FindJobByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckJobCancelled verifies that destroying a job that has not finished cancels it.
+func testAccCheckJobCancelled(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_job" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfs3control.FindJobByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if err != nil {
+				return err
+			}
+
+			if output.Status != types.JobStatusCancelled {
+				return fmt.Errorf("S3 Batch Operations Job %s has status %s, expected %s", rs.Primary.ID, output.Status, types.JobStatusCancelled)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  count = 2
+
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/object-${count.index}"
+  content = "test"
+}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["batchoperations.s3.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = [
+      "s3:GetObject",
+      "s3:GetObjectVersion",
+      "s3:PutObject",
+      "s3:PutObjectTagging",
+      "s3:PutObjectVersionTagging",
+    ]
+
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+  }
+
+  statement {
+    actions = [
+      "s3:GetBucketLocation",
+      "s3:ListBucket",
+    ]
+
+    resources = [aws_s3_bucket.test.arn]
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  name   = %[1]q
+  role   = aws_iam_role.test.id
+  policy = data.aws_iam_policy_document.test.json
+}
+`, rName)
+}
+
+func testAccJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_s3control_job" "test" {
+  priority = 10
+  role_arn = aws_iam_role.test.arn
+
+  manifest_generator {
+    source_bucket = aws_s3_bucket.test.arn
+
+    filter {
+      key_name_constraint {
+        match_any_prefix = ["data/"]
+      }
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key = "Value"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  task_failure_threshold_percentage = 0
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`)
+}
+
+func testAccJobConfig_manifest(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = join("\n", [for o in aws_s3_object.test : "${o.bucket},${o.key}"])
+}
+
+resource "aws_s3control_job" "test" {
+  description = "manifest"
+  priority    = 10
+  role_arn    = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      format = "S3BatchOperations_CSV_20180820"
+      fields = ["Bucket", "Key"]
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key = "Value"
+      }
+    }
+  }
+
+  report {
+    bucket       = aws_s3_bucket.test.arn
+    enabled      = true
+    format       = "Report_CSV_20180820"
+    prefix       = "reports"
+    report_scope = "AllTasks"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccJobConfig_confirmationRequired(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest_generator {
+    source_bucket = aws_s3_bucket.test.arn
+  }
+
+  operation {
+    s3_delete_object_tagging {}
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`)
+}
+
+func testAccJobConfig_confirmationRequiredTaskFailureThreshold(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_s3control_job" "test" {
+  confirmation_required             = true
+  priority                          = 10
+  role_arn                          = aws_iam_role.test.arn
+  task_failure_threshold_percentage = 0
+
+  manifest_generator {
+    source_bucket = aws_s3_bucket.test.arn
+  }
+
+  operation {
+    s3_delete_object_tagging {}
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`)
+}
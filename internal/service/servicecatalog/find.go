@@ -208,3 +208,114 @@ func findProductByID(ctx context.Context, conn *servicecatalog.Client, productID
 
 	return out, nil
 }
+
+func findProvisionedProductPlanByID(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, planID string) (*servicecatalog.DescribeProvisionedProductPlanOutput, error) {
+	input := &servicecatalog.DescribeProvisionedProductPlanInput{
+		PlanId: aws.String(planID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var output *servicecatalog.DescribeProvisionedProductPlanOutput
+
+	for {
+		page, err := conn.DescribeProvisionedProductPlan(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil || page.ProvisionedProductPlanDetails == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.ResourceChanges = append(output.ResourceChanges, page.ResourceChanges...)
+		}
+
+		if aws.ToString(page.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = page.NextPageToken
+	}
+
+	return output, nil
+}
+
+// findProvisionedProductPreviewPlans returns the preview plans created for the named provisioned product.
+func findProvisionedProductPreviewPlans(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, name string) ([]awstypes.ProvisionedProductPlanSummary, error) {
+	input := &servicecatalog.ListProvisionedProductPlansInput{}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var output []awstypes.ProvisionedProductPlanSummary
+
+	for {
+		page, err := conn.ListProvisionedProductPlans(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.ProvisionedProductPlans {
+			if aws.ToString(v.ProvisionProductName) == name && strings.HasPrefix(aws.ToString(v.PlanName), previewPlanNamePrefix) {
+				output = append(output, v)
+			}
+		}
+
+		if aws.ToString(page.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = page.NextPageToken
+	}
+
+	return output, nil
+}
+
+func findLaunchPathIDByName(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, productID, name string) (string, error) {
+	input := &servicecatalog.ListLaunchPathsInput{
+		ProductId: aws.String(productID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	pages := servicecatalog.NewListLaunchPathsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, v := range page.LaunchPathSummaries {
+			if aws.ToString(v.Name) == name {
+				return aws.ToString(v.Id), nil
+			}
+		}
+	}
+
+	return "", &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
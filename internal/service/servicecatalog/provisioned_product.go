@@ -5,9 +5,14 @@ package servicecatalog
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -31,7 +36,7 @@ import (
 
 // @SDKResource("aws_servicecatalog_provisioned_product", name="Provisioned Product")
 // @Tags
-// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/servicecatalog/types;types.ProvisionedProductDetail",importIgnore="accept_language;ignore_errors;provisioning_artifact_name;provisioning_parameters;retain_physical_resources", skipEmptyTags=true, noRemoveTags=true)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/servicecatalog/types;types.ProvisionedProductDetail",importIgnore="accept_language;ignore_errors;preview_resource_changes;provisioning_artifact_name;provisioning_parameters;retain_physical_resources", skipEmptyTags=true, noRemoveTags=true)
 func resourceProvisionedProduct() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProvisionedProductCreate,
@@ -137,6 +142,44 @@ func resourceProvisionedProduct() *schema.Resource {
 					"path_id",
 				},
 			},
+			"planned_resource_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrScope: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"preview_resource_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"product_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -265,6 +308,7 @@ func resourceProvisionedProduct() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			refreshOutputsDiff,
+			previewResourceChangesDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -280,6 +324,206 @@ func refreshOutputsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 	return nil
 }
 
+// previewResourceChangesDiff uses a provisioned product plan to preview the CloudFormation
+// resource changes that provisioning or updating the product would make.
+// The plan is never executed; it is deleted once the product is provisioned or updated.
+func previewResourceChangesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("preview_resource_changes").(bool) {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("path_id", "path_name", "product_id", "product_name", "provisioning_artifact_id", "provisioning_artifact_name", "provisioning_parameters") {
+		return nil
+	}
+
+	// Names are resolved to IDs below, so an ID only needs to be known if the corresponding name isn't set.
+	for _, key := range []string{names.AttrName, "notification_arns", "path_name", "product_name", "provisioning_artifact_name", "provisioning_parameters"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("planned_resource_changes")
+		}
+	}
+
+	for _, key := range [][2]string{{"product_name", "product_id"}, {"provisioning_artifact_name", "provisioning_artifact_id"}} {
+		if _, ok := diff.GetOk(key[0]); !ok && !diff.NewValueKnown(key[1]) {
+			return diff.SetNewComputed("planned_resource_changes")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+	acceptLanguage := diff.Get("accept_language").(string)
+	name := diff.Get(names.AttrName).(string)
+
+	productID := diff.Get("product_id").(string)
+	if v, ok := diff.GetOk("product_name"); ok {
+		output, err := conn.DescribeProduct(ctx, &servicecatalog.DescribeProductInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			Name:           aws.String(v.(string)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading Service Catalog Product (%s): %w", v.(string), err)
+		}
+
+		productID = aws.ToString(output.ProductViewSummary.ProductId)
+	}
+
+	artifactID := diff.Get("provisioning_artifact_id").(string)
+	if v, ok := diff.GetOk("provisioning_artifact_name"); ok {
+		output, err := conn.DescribeProvisioningArtifact(ctx, &servicecatalog.DescribeProvisioningArtifactInput{
+			AcceptLanguage:           aws.String(acceptLanguage),
+			ProductId:                aws.String(productID),
+			ProvisioningArtifactName: aws.String(v.(string)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading Service Catalog Provisioning Artifact (%s): %w", v.(string), err)
+		}
+
+		artifactID = aws.ToString(output.ProvisioningArtifactDetail.Id)
+	}
+
+	input := &servicecatalog.CreateProvisionedProductPlanInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		IdempotencyToken:       aws.String(id.UniqueId()),
+		PlanName:               aws.String(id.UniqueId()),
+		PlanType:               awstypes.ProvisionedProductPlanTypeCloudformation,
+		ProductId:              aws.String(productID),
+		ProvisionedProductName: aws.String(name),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	if v, ok := diff.GetOk("notification_arns"); ok && len(v.([]interface{})) > 0 {
+		input.NotificationArns = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := diff.GetOk("path_name"); ok {
+		pathID, err := findLaunchPathIDByName(ctx, conn, acceptLanguage, productID, v.(string))
+
+		if err != nil {
+			return fmt.Errorf("reading Service Catalog Product (%s) Launch Path (%s): %w", productID, v.(string), err)
+		}
+
+		input.PathId = aws.String(pathID)
+	} else if v, ok := diff.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := diff.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.ProvisioningParameters = expandUpdateProvisioningParameters(v.([]interface{}))
+	}
+
+	planName, err := previewPlanName(input)
+
+	if err != nil {
+		return err
+	}
+
+	input.PlanName = aws.String(planName)
+
+	// Terraform plans the resource again during apply. The plan name is derived from the inputs, so that
+	// plan reuses the preview created at plan time instead of creating a second, possibly different, one.
+	plans, err := findProvisionedProductPreviewPlans(ctx, conn, acceptLanguage, name)
+
+	if err != nil {
+		return fmt.Errorf("listing Service Catalog Provisioned Product (%s) Plans: %w", name, err)
+	}
+
+	var planID string
+
+	for _, v := range plans {
+		if planID == "" && aws.ToString(v.PlanName) == planName {
+			planID = aws.ToString(v.PlanId)
+			continue
+		}
+
+		// Previews of other inputs are left over from earlier plan-only runs.
+		deleteProvisionedProductPlan(ctx, conn, acceptLanguage, aws.ToString(v.PlanId))
+	}
+
+	if planID == "" {
+		output, err := conn.CreateProvisionedProductPlan(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("creating Service Catalog Provisioned Product (%s) Plan: %w", name, err)
+		}
+
+		planID = aws.ToString(output.PlanId)
+	}
+
+	plan, err := waitProvisionedProductPlanCreated(ctx, conn, acceptLanguage, planID, ProvisionedProductPlanReadyTimeout)
+
+	if err != nil {
+		deleteProvisionedProductPlan(ctx, conn, acceptLanguage, planID)
+
+		return fmt.Errorf("waiting for Service Catalog Provisioned Product Plan (%s) create: %w", planID, err)
+	}
+
+	return diff.SetNew("planned_resource_changes", flattenResourceChanges(plan.ResourceChanges))
+}
+
+const (
+	previewPlanNamePrefix = "terraform-preview-"
+)
+
+// previewPlanName returns the name of the preview plan for the specified plan inputs.
+func previewPlanName(input *servicecatalog.CreateProvisionedProductPlanInput) (string, error) {
+	input = &servicecatalog.CreateProvisionedProductPlanInput{
+		AcceptLanguage:         input.AcceptLanguage,
+		NotificationArns:       input.NotificationArns,
+		PathId:                 input.PathId,
+		PlanType:               input.PlanType,
+		ProductId:              input.ProductId,
+		ProvisionedProductName: input.ProvisionedProductName,
+		ProvisioningArtifactId: input.ProvisioningArtifactId,
+		ProvisioningParameters: input.ProvisioningParameters,
+	}
+
+	b, err := json.Marshal(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(b)
+
+	return previewPlanNamePrefix + hex.EncodeToString(hash[:16]), nil
+}
+
+func deleteProvisionedProductPlan(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, planID string) {
+	// Delete the plan even if the operation is cancelled.
+	ctx = context.WithoutCancel(ctx)
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioned Product Plan: %s", planID)
+	_, err := conn.DeleteProvisionedProductPlan(ctx, &servicecatalog.DeleteProvisionedProductPlanInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		IgnoreErrors:   true,
+		PlanId:         aws.String(planID),
+	})
+
+	if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		log.Printf("[WARN] deleting Service Catalog Provisioned Product Plan (%s): %s", planID, err)
+	}
+}
+
+// deleteProvisionedProductPreviewPlans deletes any preview plans created for the provisioned product.
+func deleteProvisionedProductPreviewPlans(ctx context.Context, conn *servicecatalog.Client, d *schema.ResourceData) {
+	if !d.Get("preview_resource_changes").(bool) {
+		return
+	}
+
+	acceptLanguage, name := d.Get("accept_language").(string), d.Get(names.AttrName).(string)
+	plans, err := findProvisionedProductPreviewPlans(ctx, conn, acceptLanguage, name)
+
+	if err != nil {
+		log.Printf("[WARN] listing Service Catalog Provisioned Product (%s) Plans: %s", name, err)
+		return
+	}
+
+	for _, v := range plans {
+		deleteProvisionedProductPlan(ctx, conn, acceptLanguage, aws.ToString(v.PlanId))
+	}
+}
+
 func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
@@ -374,6 +618,8 @@ func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Provisioned Product (%s) create: %s", d.Id(), err)
 	}
 
+	deleteProvisionedProductPreviewPlans(ctx, conn, d)
+
 	return append(diags, resourceProvisionedProductRead(ctx, d, meta)...)
 }
 
@@ -490,6 +736,11 @@ func resourceProvisionedProductUpdate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
+	// preview_resource_changes only affects planning, so don't update the provisioned product.
+	if !d.HasChangesExcept("preview_resource_changes") {
+		return append(diags, resourceProvisionedProductRead(ctx, d, meta)...)
+	}
+
 	input := &servicecatalog.UpdateProvisionedProductInput{
 		UpdateToken:          aws.String(id.UniqueId()),
 		ProvisionedProductId: aws.String(d.Id()),
@@ -560,6 +811,8 @@ func resourceProvisionedProductUpdate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Provisioned Product (%s) update: %s", d.Id(), err)
 	}
 
+	deleteProvisionedProductPreviewPlans(ctx, conn, d)
+
 	return append(diags, resourceProvisionedProductRead(ctx, d, meta)...)
 }
 
@@ -567,6 +820,8 @@ func resourceProvisionedProductDelete(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
+	deleteProvisionedProductPreviewPlans(ctx, conn, d)
+
 	input := &servicecatalog.TerminateProvisionedProductInput{
 		TerminateToken:       aws.String(id.UniqueId()),
 		ProvisionedProductId: aws.String(d.Id()),
@@ -788,3 +1043,31 @@ func flattenRecordOutputs(apiObjects []awstypes.RecordOutput) []interface{} {
 
 	return tfList
 }
+
+func flattenResourceChanges(apiObjects []awstypes.ResourceChange) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	// Sort by logical resource ID so that the planned changes are stable between plans.
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortFunc(apiObjects, func(a, b awstypes.ResourceChange) int {
+		return strings.Compare(aws.ToString(a.LogicalResourceId), aws.ToString(b.LogicalResourceId))
+	})
+
+	for _, apiObject := range apiObjects {
+		var scope []string
+		for _, v := range apiObject.Scope {
+			scope = append(scope, string(v))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:       apiObject.Action,
+			"logical_resource_id":  aws.ToString(apiObject.LogicalResourceId),
+			"physical_resource_id": aws.ToString(apiObject.PhysicalResourceId),
+			"replacement":          apiObject.Replacement,
+			names.AttrResourceType: aws.ToString(apiObject.ResourceType),
+			names.AttrScope:        scope,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_servicecatalog_provisioned_product_outputs", name="Provisioned Product Outputs")
+func dataSourceProvisionedProductOutputs() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProvisionedProductOutputsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      acceptLanguageEnglish,
+				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
+			},
+			"output_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"outputs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"provisioned_product_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"provisioned_product_id", "provisioned_product_name"},
+			},
+			"provisioned_product_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"provisioned_product_id", "provisioned_product_name"},
+			},
+			names.AttrValues: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceProvisionedProductOutputsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	acceptLanguage := d.Get("accept_language").(string)
+	input := &servicecatalog.DescribeProvisionedProductInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+	}
+
+	if v, ok := d.GetOk("provisioned_product_id"); ok {
+		input.Id = aws.String(v.(string))
+	} else if v, ok := d.GetOk("provisioned_product_name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	product, err := conn.DescribeProvisionedProduct(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Provisioned Product: %s", err)
+	}
+
+	if product == nil || product.ProvisionedProductDetail == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Provisioned Product: empty response")
+	}
+
+	productID := aws.ToString(product.ProvisionedProductDetail.Id)
+	outputsInput := &servicecatalog.GetProvisionedProductOutputsInput{
+		AcceptLanguage:       aws.String(acceptLanguage),
+		ProvisionedProductId: aws.String(productID),
+	}
+
+	if v, ok := d.GetOk("output_keys"); ok && len(v.([]interface{})) > 0 {
+		outputsInput.OutputKeys = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	outputs, err := findProvisionedProductOutputs(ctx, conn, outputsInput)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Provisioned Product (%s) outputs: %s", productID, err)
+	}
+
+	d.SetId(productID)
+	if err := d.Set("outputs", flattenRecordOutputs(outputs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting outputs: %s", err)
+	}
+	d.Set("provisioned_product_id", productID)
+	d.Set("provisioned_product_name", product.ProvisionedProductDetail.Name)
+	values := make(map[string]string, len(outputs))
+	for _, v := range outputs {
+		values[aws.ToString(v.OutputKey)] = aws.ToString(v.OutputValue)
+	}
+	d.Set(names.AttrValues, values)
+
+	return diags
+}
+
+func findProvisionedProductOutputs(ctx context.Context, conn *servicecatalog.Client, input *servicecatalog.GetProvisionedProductOutputsInput) ([]awstypes.RecordOutput, error) {
+	var output []awstypes.RecordOutput
+
+	pages := servicecatalog.NewGetProvisionedProductOutputsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Outputs...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceCatalogProvisionedProductOutputsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_servicecatalog_provisioned_product_outputs.test"
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductOutputsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "provisioned_product_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "provisioned_product_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "outputs.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "outputs.0.description", "VPC ID"),
+					resource.TestCheckResourceAttr(dataSourceName, "outputs.0.key", "VpcID"),
+					resource.TestMatchResourceAttr(dataSourceName, "values.VpcID", regexache.MustCompile(`^vpc-.+`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccProvisionedProductOutputsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductConfig_basic(rName, "10.1.0.0/16"), `
+data "aws_servicecatalog_provisioned_product_outputs" "test" {
+  provisioned_product_name = aws_servicecatalog_provisioned_product.test.name
+  output_keys              = ["VpcID"]
+}
+`)
+}
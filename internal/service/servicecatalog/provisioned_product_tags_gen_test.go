@@ -69,7 +69,7 @@ func TestAccServiceCatalogProvisionedProduct_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -117,7 +117,7 @@ func TestAccServiceCatalogProvisionedProduct_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -161,7 +161,7 @@ func TestAccServiceCatalogProvisionedProduct_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -196,7 +196,7 @@ func TestAccServiceCatalogProvisionedProduct_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -251,7 +251,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_null(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -340,7 +340,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_AddOnUpdate(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -400,7 +400,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnCreate(t *testing.T
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -433,7 +433,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnCreate(t *testing.T
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -525,7 +525,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnUpdate_Add(t *testi
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -568,7 +568,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnUpdate_Add(t *testi
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -656,7 +656,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnUpdate_Replace(t *t
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -717,7 +717,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_providerOnly(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -767,7 +767,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_providerOnly(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -814,7 +814,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_providerOnly(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -852,7 +852,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_providerOnly(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -924,7 +924,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nonOverlapping(t *
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -986,7 +986,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nonOverlapping(t *
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -1023,7 +1023,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nonOverlapping(t *
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -1093,7 +1093,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_overlapping(t *tes
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -1155,7 +1155,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_overlapping(t *tes
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -1209,7 +1209,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_overlapping(t *tes
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1302,7 +1302,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_updateToProviderOn
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1394,7 +1394,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_updateToResourceOn
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1464,7 +1464,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_emptyResourceTag(t
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1526,7 +1526,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_emptyProviderOnlyT
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1591,7 +1591,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nullOverlappingRes
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1656,7 +1656,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nullNonOverlapping
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1713,7 +1713,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_ComputedTag_OnCreate(t *testin
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1806,7 +1806,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_ComputedTag_OnUpdate_Add(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1892,7 +1892,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_ComputedTag_OnUpdate_Replace(t
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", "preview_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
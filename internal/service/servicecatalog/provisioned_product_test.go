@@ -15,6 +15,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -71,6 +72,7 @@ func TestAccServiceCatalogProvisionedProduct_basic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
 					"ignore_errors",
+					"preview_resource_changes",
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
@@ -137,6 +139,7 @@ func TestAccServiceCatalogProvisionedProduct_update(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
 					"ignore_errors",
+					"preview_resource_changes",
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
@@ -177,6 +180,7 @@ func TestAccServiceCatalogProvisionedProduct_stackSetProvisioningPreferences(t *
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
 					"ignore_errors",
+					"preview_resource_changes",
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
@@ -246,6 +250,7 @@ func TestAccServiceCatalogProvisionedProduct_ProductName_update(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
 					"ignore_errors",
+					"preview_resource_changes",
 					"product_name",
 					"provisioning_artifact_name",
 					"provisioning_parameters",
@@ -342,6 +347,82 @@ func TestAccServiceCatalogProvisionedProduct_computedOutputs(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogProvisionedProduct_previewResourceChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_previewResourceChanges(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.0.action", string(awstypes.ChangeActionAdd)),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.0.resource_type", "AWS::EC2::VPC"),
+					resource.TestCheckResourceAttr(resourceName, "preview_resource_changes", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccProvisionedProductConfig_previewResourceChanges(rName, "10.10.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.0.action", string(awstypes.ChangeActionModify)),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttrSet(resourceName, "planned_resource_changes.0.physical_resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.0.replacement", string(awstypes.ReplacementTrue)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisionedProduct_previewResourceChangesToggle(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod1, pprod2 awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_previewResourceChangesToggle(rName, "10.1.0.0/16", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod1),
+					resource.TestCheckResourceAttr(resourceName, "preview_resource_changes", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccProvisionedProductConfig_previewResourceChangesToggle(rName, "10.1.0.0/16", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod2),
+					testAccCheckProvisionedProductNotUpdated(&pprod1, &pprod2),
+					resource.TestCheckResourceAttr(resourceName, "preview_resource_changes", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceCatalogProvisionedProduct_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioned_product.test"
@@ -526,6 +607,16 @@ func testAccCheckProvisionedProductProvisioningArtifactIDChanged(pprod1, pprod2
 	}
 }
 
+func testAccCheckProvisionedProductNotUpdated(pprod1, pprod2 *awstypes.ProvisionedProductDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(pprod1.LastRecordId) != aws.ToString(pprod2.LastRecordId) {
+			return fmt.Errorf("provisioned product last record ID changed from %s to %s", aws.ToString(pprod1.LastRecordId), aws.ToString(pprod2.LastRecordId))
+		}
+
+		return nil
+	}
+}
+
 func testAccProvisionedProductPortfolioBaseConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_servicecatalog_portfolio" "test" {
@@ -809,6 +900,52 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 `, rName, vpcCidr))
 }
 
+func testAccProvisionedProductConfig_previewResourceChanges(rName, vpcCidr string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+  preview_resource_changes   = true
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+}
+`, rName, vpcCidr))
+}
+
+func testAccProvisionedProductConfig_previewResourceChangesToggle(rName, vpcCidr string, preview bool) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+  preview_resource_changes   = %[3]t
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+}
+`, rName, vpcCidr, preview))
+}
+
 func testAccProvisionedProductConfig_computedOutputs(rName, vpcCidr string) string {
 	return acctest.ConfigCompose(testAccProvisionedProductPhysicalTemplateIDBaseConfig(rName),
 		fmt.Sprintf(`
@@ -51,6 +51,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Product",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceProvisionedProductOutputs,
+			TypeName: "aws_servicecatalog_provisioned_product_outputs",
+			Name:     "Provisioned Product Outputs",
+		},
 		{
 			Factory:  dataSourceProvisioningArtifacts,
 			TypeName: "aws_servicecatalog_provisioning_artifacts",
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -43,6 +44,7 @@ const (
 	ProductReadyTimeout                       = 5 * time.Minute
 	ProductUpdateTimeout                      = 5 * time.Minute
 	ProvisionedProductDeleteTimeout           = 30 * time.Minute
+	ProvisionedProductPlanReadyTimeout        = 10 * time.Minute
 	ProvisionedProductReadTimeout             = 10 * time.Minute
 	ProvisionedProductReadyTimeout            = 30 * time.Minute
 	ProvisionedProductUpdateTimeout           = 30 * time.Minute
@@ -508,6 +510,25 @@ func waitProvisionedProductTerminated(ctx context.Context, conn *servicecatalog.
 	return err
 }
 
+func waitProvisionedProductPlanCreated(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, planID string, timeout time.Duration) (*servicecatalog.DescribeProvisionedProductPlanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProvisionedProductPlanStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.ProvisionedProductPlanStatusCreateSuccess),
+		Refresh: statusProvisionedProductPlan(ctx, conn, acceptLanguage, planID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*servicecatalog.DescribeProvisionedProductPlanOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ProvisionedProductPlanDetails.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitPortfolioConstraintsReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, portfolioID, productID string, timeout time.Duration) ([]awstypes.ConstraintDetail, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{statusNotFound},
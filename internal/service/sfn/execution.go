@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sfn_execution", name="Execution")
+func resourceExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceExecutionCreate,
+		ReadWithoutTimeout:   resourceExecutionRead,
+		DeleteWithoutTimeout: resourceExecutionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cause": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "{}",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 80),
+			},
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_machine_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stop_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	stateMachineARN := d.Get("state_machine_arn").(string)
+	stateMachine, err := findStateMachineByARN(ctx, conn, stateMachineARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Step Functions State Machine (%s): %s", stateMachineARN, err)
+	}
+
+	if stateMachine.Type == awstypes.StateMachineTypeExpress {
+		input := &sfn.StartSyncExecutionInput{
+			Input:           aws.String(d.Get("input").(string)),
+			StateMachineArn: aws.String(stateMachineARN),
+		}
+
+		if v, ok := d.GetOk(names.AttrName); ok {
+			input.Name = aws.String(v.(string))
+		}
+
+		output, err := conn.StartSyncExecution(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "starting Step Functions Execution (%s): %s", stateMachineARN, err)
+		}
+
+		// Express executions have no execution history, so the result is only available here.
+		d.SetId(aws.ToString(output.ExecutionArn))
+		d.Set(names.AttrARN, output.ExecutionArn)
+		d.Set("cause", output.Cause)
+		d.Set("error", output.Error)
+		d.Set(names.AttrName, output.Name)
+		d.Set("output", output.Output)
+		d.Set("start_date", flattenExecutionDate(output.StartDate))
+		d.Set(names.AttrStatus, output.Status)
+		d.Set("stop_date", flattenExecutionDate(output.StopDate))
+
+		if status := output.Status; status != awstypes.SyncExecutionStatusSucceeded {
+			return sdkdiag.AppendErrorf(diags, "Step Functions Execution (%s) %s: %s: %s", d.Id(), status, aws.ToString(output.Error), aws.ToString(output.Cause))
+		}
+
+		return diags
+	}
+
+	input := &sfn.StartExecutionInput{
+		Input:           aws.String(d.Get("input").(string)),
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+
+	if v, ok := d.GetOk(names.AttrName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	output, err := conn.StartExecution(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Step Functions Execution (%s): %s", stateMachineARN, err)
+	}
+
+	d.SetId(aws.ToString(output.ExecutionArn))
+
+	if _, err := waitExecutionSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "waiting for Step Functions Execution (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceExecutionRead(ctx, d, meta)...)
+}
+
+func resourceExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	if isExpressExecutionARN(d.Id()) {
+		// DescribeExecution does not support Express executions.
+		return diags
+	}
+
+	output, err := findExecutionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// Execution history is only retained for 90 days after an execution closes.
+		// Keep the recorded result rather than starting the execution again.
+		log.Printf("[WARN] Step Functions Execution (%s) history not found, keeping recorded result", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Step Functions Execution (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ExecutionArn)
+	d.Set("cause", output.Cause)
+	d.Set("error", output.Error)
+	d.Set("input", output.Input)
+	d.Set(names.AttrName, output.Name)
+	d.Set("output", output.Output)
+	d.Set("start_date", flattenExecutionDate(output.StartDate))
+	d.Set("state_machine_arn", output.StateMachineArn)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("stop_date", flattenExecutionDate(output.StopDate))
+
+	return diags
+}
+
+func resourceExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	if isExpressExecutionARN(d.Id()) {
+		return diags
+	}
+
+	output, err := findExecutionByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Step Functions Execution (%s): %s", d.Id(), err)
+	}
+
+	// Completed executions cannot be deleted; only stop an execution that is still running.
+	if output.Status != awstypes.ExecutionStatusRunning {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Stopping Step Functions Execution: %s", d.Id())
+	_, err = conn.StopExecution(ctx, &sfn.StopExecutionInput{
+		ExecutionArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ExecutionDoesNotExist](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping Step Functions Execution (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findExecutionByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeExecutionOutput, error) {
+	input := &sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExecution(ctx, input)
+
+	if errs.IsA[*awstypes.ExecutionDoesNotExist](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusExecution(ctx context.Context, conn *sfn.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findExecutionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitExecutionSucceeded(ctx context.Context, conn *sfn.Client, arn string, timeout time.Duration) (*sfn.DescribeExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ExecutionStatusRunning),
+		Target:  enum.Slice(awstypes.ExecutionStatusSucceeded),
+		Refresh: statusExecution(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sfn.DescribeExecutionOutput); ok {
+		tfresource.SetLastError(err, executionError(output.Error, output.Cause))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func executionError(errorCode, cause *string) error {
+	if errorCode == nil && cause == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.ToString(errorCode), aws.ToString(cause))
+}
+
+// isExpressExecutionARN returns whether the specified ARN is that of an Express execution.
+// Express execution ARNs have the form arn:aws:states:region:account:express:stateMachine:execution:id.
+func isExpressExecutionARN(s string) bool {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(v.Resource, "express:")
+}
+
+func flattenExecutionDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.ToTime(t).Format(time.RFC3339)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var execution sfn.DescribeExecutionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecutionConfig_basic(rName, "STANDARD", "Pass", "a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExecutionExists(ctx, resourceName, &execution),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "states", regexache.MustCompile(`execution:.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "SUCCEEDED"),
+					resource.TestCheckResourceAttr(resourceName, "output", `{"trigger":"a","greeting":"hello"}`),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "stop_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNExecution_express(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecutionConfig_basic(rName, "EXPRESS", "Pass", "a"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "states", regexache.MustCompile(`express:.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "SUCCEEDED"),
+					resource.TestCheckResourceAttr(resourceName, "output", `{"trigger":"a","greeting":"hello"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNExecution_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExecutionConfig_basic(rName, "STANDARD", "Fail", "a"),
+				ExpectError: regexache.MustCompile(`unexpected state 'FAILED'.*TestError: test failure`),
+			},
+		},
+	})
+}
+
+func TestAccSFNExecution_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var execution1, execution2 sfn.DescribeExecutionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecutionConfig_basic(rName, "STANDARD", "Pass", "a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExecutionExists(ctx, resourceName, &execution1),
+				),
+			},
+			{
+				Config: testAccExecutionConfig_basic(rName, "STANDARD", "Pass", "b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExecutionExists(ctx, resourceName, &execution2),
+					testAccCheckExecutionRecreated(&execution1, &execution2),
+					resource.TestCheckResourceAttr(resourceName, "output", `{"trigger":"b","greeting":"hello"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExecutionExists(ctx context.Context, n string, v *sfn.DescribeExecutionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNClient(ctx)
+
+		output, err := tfsfn.FindExecutionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckExecutionRecreated(before, after *sfn.DescribeExecutionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := *before.ExecutionArn, *after.ExecutionArn; before == after {
+			return fmt.Errorf("Step Functions Execution (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccExecutionConfig_base(rName, rType, stateType string) string {
+	state := `{
+        Type       = "Pass"
+        Result     = "hello"
+        ResultPath = "$.greeting"
+        End        = true
+      }`
+	if stateType == "Fail" {
+		state = `{
+        Type  = "Fail"
+        Error = "TestError"
+        Cause = "test failure"
+      }`
+	}
+
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "states.${data.aws_region.current.name}.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  type     = %[2]q
+
+  definition = jsonencode({
+    StartAt = "Test"
+    States = {
+      Test = %[3]s
+    }
+  })
+}
+`, rName, rType, state)
+}
+
+func testAccExecutionConfig_basic(rName, rType, stateType, trigger string) string {
+	return acctest.ConfigCompose(testAccExecutionConfig_base(rName, rType, stateType), fmt.Sprintf(`
+resource "aws_sfn_execution" "test" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+
+  input = jsonencode({
+    trigger = %[1]q
+  })
+
+  triggers = {
+    trigger = %[1]q
+  }
+}
+`, trigger))
+}
@@ -7,9 +7,11 @@ package sfn
 var (
 	ResourceActivity     = resourceActivity
 	ResourceAlias        = resourceAlias
+	ResourceExecution    = resourceExecution
 	ResourceStateMachine = resourceStateMachine
 
 	FindActivityByARN     = findActivityByARN
 	FindAliasByARN        = findAliasByARN
+	FindExecutionByARN    = findExecutionByARN
 	FindStateMachineByARN = findStateMachineByARN
 )
@@ -44,6 +44,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_sfn_state_machine_versions",
 			Name:     "State Machine Versions",
 		},
+		{
+			Factory:  dataSourceTestState,
+			TypeName: "aws_sfn_test_state",
+			Name:     "Test State",
+		},
 	}
 }
 
@@ -62,6 +67,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_sfn_alias",
 			Name:     "Alias",
 		},
+		{
+			Factory:  resourceExecution,
+			TypeName: "aws_sfn_execution",
+			Name:     "Execution",
+		},
 		{
 			Factory:  resourceStateMachine,
 			TypeName: "aws_sfn_state_machine",
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sfn_test_state", name="Test State")
+func dataSourceTestState() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTestStateRead,
+
+		Schema: map[string]*schema.Schema{
+			"cause": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"error": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"inspection_data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"after_input_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"after_parameters": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"after_result_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"after_result_selector": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"input": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"body": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"headers": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"method": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrProtocol: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrURL: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"response": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"body": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"headers": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrProtocol: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrStatusCode: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status_message": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"inspection_level": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(awstypes.InspectionLevelInfo),
+				ValidateDiagFunc: enum.Validate[awstypes.InspectionLevel](),
+			},
+			"next_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reveal_secrets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTestStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	input := &sfn.TestStateInput{
+		Definition:      aws.String(d.Get("definition").(string)),
+		InspectionLevel: awstypes.InspectionLevel(d.Get("inspection_level").(string)),
+		RevealSecrets:   d.Get("reveal_secrets").(bool),
+		RoleArn:         aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	if v, ok := d.GetOk("input"); ok {
+		input.Input = aws.String(v.(string))
+	}
+
+	output, err := conn.TestState(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing Step Functions State: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("cause", output.Cause)
+	d.Set("error", output.Error)
+	if err := d.Set("inspection_data", flattenInspectionData(output.InspectionData)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting inspection_data: %s", err)
+	}
+	d.Set("next_state", output.NextState)
+	d.Set("output", output.Output)
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func flattenInspectionData(apiObject *awstypes.InspectionData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"after_input_path":      aws.ToString(apiObject.AfterInputPath),
+		"after_parameters":      aws.ToString(apiObject.AfterParameters),
+		"after_result_path":     aws.ToString(apiObject.AfterResultPath),
+		"after_result_selector": aws.ToString(apiObject.AfterResultSelector),
+		"input":                 aws.ToString(apiObject.Input),
+		"result":                aws.ToString(apiObject.Result),
+	}
+
+	if v := apiObject.Request; v != nil {
+		tfMap["request"] = []interface{}{map[string]interface{}{
+			"body":             aws.ToString(v.Body),
+			"headers":          aws.ToString(v.Headers),
+			"method":           aws.ToString(v.Method),
+			names.AttrProtocol: aws.ToString(v.Protocol),
+			names.AttrURL:      aws.ToString(v.Url),
+		}}
+	}
+
+	if v := apiObject.Response; v != nil {
+		tfMap["response"] = []interface{}{map[string]interface{}{
+			"body":               aws.ToString(v.Body),
+			"headers":            aws.ToString(v.Headers),
+			names.AttrProtocol:   aws.ToString(v.Protocol),
+			names.AttrStatusCode: aws.ToString(v.StatusCode),
+			"status_message":     aws.ToString(v.StatusMessage),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
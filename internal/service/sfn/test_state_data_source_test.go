@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNTestStateDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sfn_test_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestStateDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "SUCCEEDED"),
+					resource.TestCheckResourceAttr(dataSourceName, "next_state", "Next"),
+					resource.TestCheckResourceAttr(dataSourceName, "output", `{"value":1,"result":{"value":1}}`),
+					resource.TestCheckResourceAttr(dataSourceName, "inspection_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "inspection_data.0.after_parameters", `{"value":1}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccTestStateDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "states.${data.aws_region.current.name}.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+data "aws_sfn_test_state" "test" {
+  role_arn         = aws_iam_role.test.arn
+  inspection_level = "DEBUG"
+
+  definition = jsonencode({
+    Type = "Pass"
+    Parameters = {
+      "value.$" = "$.value"
+    }
+    ResultPath = "$.result"
+    Next       = "Next"
+  })
+
+  input = jsonencode({
+    value = 1
+  })
+}
+`, rName)
+}
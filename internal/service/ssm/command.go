@@ -0,0 +1,576 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssm_command", name="Command")
+func resourceCommand() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCommandCreate,
+		ReadWithoutTimeout:   resourceCommandRead,
+		DeleteWithoutTimeout: resourceCommandDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"command_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrComment: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"document_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)$`), ""),
+			},
+			"instance_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     50,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			"invocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"plugins": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"response_code": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"standard_error_content": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"standard_error_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"standard_output_content": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"standard_output_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrStatus: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"max_errors": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"output_location": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrS3BucketName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						names.AttrS3KeyPrefix: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 500),
+						},
+						"s3_region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 20),
+						},
+					},
+				},
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 163),
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	documentName := d.Get("document_name").(string)
+	input := &ssm.SendCommandInput{
+		DocumentName: aws.String(documentName),
+	}
+
+	if v, ok := d.GetOk(names.AttrComment); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("document_version"); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_errors"); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.OutputS3BucketName = aws.String(tfMap[names.AttrS3BucketName].(string))
+
+		if v, ok := tfMap[names.AttrS3KeyPrefix].(string); ok && v != "" {
+			input.OutputS3KeyPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["s3_region"].(string); ok && v != "" {
+			input.OutputS3Region = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.(map[string]interface{})) > 0 {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_role_arn"); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("targets"); ok && len(v.([]interface{})) > 0 {
+		input.Targets = expandTargets(v.([]interface{}))
+	}
+
+	// Newly launched instances may not yet be registered with Systems Manager.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.InvalidInstanceId](ctx, instanceRegistrationTimeout, func() (interface{}, error) {
+		return conn.SendCommand(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "sending SSM Command (%s): %s", documentName, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*ssm.SendCommandOutput).Command.CommandId))
+
+	if _, err := waitCommandSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "waiting for SSM Command (%s) complete: %s", d.Id(), err)
+
+		if invocations, err := findCommandInvocationsByID(ctx, conn, d.Id()); err == nil {
+			for _, v := range invocations {
+				if v.Status != awstypes.CommandInvocationStatusSuccess {
+					diags = sdkdiag.AppendErrorf(diags, "SSM Command (%s) invocation on instance (%s) %s: %s", d.Id(), aws.ToString(v.InstanceId), v.Status, aws.ToString(v.StatusDetails))
+				}
+			}
+		}
+	}
+
+	return append(diags, resourceCommandRead(ctx, d, meta)...)
+}
+
+func resourceCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	command, err := findCommandByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// Command history is only retained for 30 days.
+		// Keep the recorded result rather than sending the command again.
+		log.Printf("[WARN] SSM Command (%s) history not found, keeping recorded result", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s): %s", d.Id(), err)
+	}
+
+	invocations, err := findCommandInvocationsByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s) invocations: %s", d.Id(), err)
+	}
+
+	// A finished plugin's output never changes, so only read the output of plugins that haven't been recorded as finished.
+	recorded := recordedCommandPluginOutputs(d.Get("invocations").([]interface{}))
+	tfList := make([]interface{}, 0, len(invocations))
+	for _, invocation := range invocations {
+		tfMap, err := flattenCommandInvocation(ctx, conn, &invocation, recorded)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s) invocation (%s): %s", d.Id(), aws.ToString(invocation.InstanceId), err)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.Set("command_id", command.CommandId)
+	if err := d.Set("invocations", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting invocations: %s", err)
+	}
+	d.Set(names.AttrStatus, command.Status)
+	d.Set("status_details", command.StatusDetails)
+
+	return diags
+}
+
+func resourceCommandDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	command, err := findCommandByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s): %s", d.Id(), err)
+	}
+
+	// Completed commands cannot be deleted; only cancel a command that is still running.
+	switch command.Status {
+	case awstypes.CommandStatusPending, awstypes.CommandStatusInProgress:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling SSM Command: %s", d.Id())
+	_, err = conn.CancelCommand(ctx, &ssm.CancelCommandInput{
+		CommandId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling SSM Command (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, input)
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationsByID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   true,
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationByThreePartKey(ctx context.Context, conn *ssm.Client, commandID, instanceID, pluginName string) (*ssm.GetCommandInvocationOutput, error) {
+	input := &ssm.GetCommandInvocationInput{
+		CommandId:  aws.String(commandID),
+		InstanceId: aws.String(instanceID),
+		PluginName: aws.String(pluginName),
+	}
+
+	output, err := conn.GetCommandInvocation(ctx, input)
+
+	if errs.IsA[*awstypes.InvocationDoesNotExist](err) || errs.IsA[*awstypes.InvalidCommandId](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusCommand(ctx context.Context, conn *ssm.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCommandByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCommandSucceeded(ctx context.Context, conn *ssm.Client, id string, timeout time.Duration) (*awstypes.Command, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CommandStatusPending, awstypes.CommandStatusInProgress),
+		Target:  enum.Slice(awstypes.CommandStatusSuccess),
+		Refresh: statusCommand(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+		// The command may not be immediately visible after SendCommand.
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Command); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// recordedCommandPluginOutputs returns the recorded plugins of finished command invocations keyed by instance ID and plugin name.
+func recordedCommandPluginOutputs(tfList []interface{}) map[string]map[string]interface{} {
+	outputs := make(map[string]map[string]interface{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		instanceID := tfMap[names.AttrInstanceID].(string)
+
+		for _, pluginRaw := range tfMap["plugins"].([]interface{}) {
+			plugin, ok := pluginRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch awstypes.CommandPluginStatus(plugin[names.AttrStatus].(string)) {
+			case awstypes.CommandPluginStatusPending, awstypes.CommandPluginStatusInProgress, "":
+				continue
+			}
+
+			outputs[instanceID+"/"+plugin[names.AttrName].(string)] = plugin
+		}
+	}
+
+	return outputs
+}
+
+func flattenCommandInvocation(ctx context.Context, conn *ssm.Client, apiObject *awstypes.CommandInvocation, recorded map[string]map[string]interface{}) (map[string]interface{}, error) {
+	commandID, instanceID := aws.ToString(apiObject.CommandId), aws.ToString(apiObject.InstanceId)
+	plugins := make([]interface{}, 0, len(apiObject.CommandPlugins))
+
+	for _, plugin := range apiObject.CommandPlugins {
+		pluginName := aws.ToString(plugin.Name)
+		tfMap := map[string]interface{}{
+			names.AttrName:        pluginName,
+			"response_code":       plugin.ResponseCode,
+			names.AttrStatus:      string(plugin.Status),
+			"standard_error_url":  aws.ToString(plugin.StandardErrorUrl),
+			"standard_output_url": aws.ToString(plugin.StandardOutputUrl),
+		}
+
+		if v, ok := recorded[instanceID+"/"+pluginName]; ok && v[names.AttrStatus] == string(plugin.Status) {
+			tfMap["standard_error_content"] = v["standard_error_content"]
+			tfMap["standard_output_content"] = v["standard_output_content"]
+			plugins = append(plugins, tfMap)
+			continue
+		}
+
+		// ListCommandInvocations only returns stdout and stderr combined, so read them separately.
+		// GetCommandInvocation returns each truncated to 24,000 characters.
+		output, err := findCommandInvocationByThreePartKey(ctx, conn, commandID, instanceID, pluginName)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return nil, fmt.Errorf("plugin (%s): %w", pluginName, err)
+		default:
+			tfMap["standard_error_content"] = aws.ToString(output.StandardErrorContent)
+			tfMap["standard_output_content"] = aws.ToString(output.StandardOutputContent)
+		}
+
+		plugins = append(plugins, tfMap)
+	}
+
+	return map[string]interface{}{
+		names.AttrInstanceID: instanceID,
+		"plugins":            plugins,
+		names.AttrStatus:     string(apiObject.Status),
+		"status_details":     aws.ToString(apiObject.StatusDetails),
+	}, nil
+}
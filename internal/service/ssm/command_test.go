@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMCommand_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var command awstypes.Command
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommandConfig_basic(rName, "echo hello", "a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName, &command),
+					resource.TestCheckResourceAttrSet(resourceName, "command_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.CommandStatusSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "invocations.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "invocations.0.instance_id", "aws_instance.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.status", string(awstypes.CommandInvocationStatusSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.plugins.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.plugins.0.response_code", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "invocations.0.plugins.0.standard_output_content", "hello\n"),
+				),
+			},
+			{
+				Config: testAccCommandConfig_basic(rName, "echo hello", "b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommandRecreated(resourceName, &command),
+					testAccCheckCommandExists(ctx, resourceName, &command),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommand_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCommandConfig_basic(rName, "exit 1", "a"),
+				ExpectError: regexache.MustCompile(`unexpected state 'Failed'`),
+			},
+		},
+	})
+}
+
+func testAccCheckCommandExists(ctx context.Context, n string, v *awstypes.Command) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindCommandByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCommandRecreated(n string, before *awstypes.Command) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == *before.CommandId {
+			return fmt.Errorf("SSM Command (%s) not recreated", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCommandConfig_basic(rName, command, trigger string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+resource "aws_ssm_command" "test" {
+  document_name = "AWS-RunShellScript"
+  instance_ids  = [aws_instance.test.id]
+
+  parameters = {
+    commands = %[1]q
+  }
+
+  triggers = {
+    trigger = %[2]q
+  }
+}
+`, command, trigger))
+}
@@ -74,6 +74,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Association",
 			},
 		},
+		{
+			Factory:  resourceCommand,
+			TypeName: "aws_ssm_command",
+			Name:     "Command",
+		},
 		{
 			Factory:  resourceDefaultPatchBaseline,
 			TypeName: "aws_ssm_default_patch_baseline",
@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_timestreaminfluxdb_db_parameter_group", name="DB Parameter Group")
+// @Tags(identifierAttribute="arn")
+func newResourceDBParameterGroup(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDBParameterGroup{}
+
+	return r, nil
+}
+
+const (
+	ResNameDBParameterGroup = "DB Parameter Group"
+)
+
+type resourceDBParameterGroup struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[resourceDBParameterGroupData]
+}
+
+func (r *resourceDBParameterGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_timestreaminfluxdb_db_parameter_group"
+}
+
+func (r *resourceDBParameterGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: `A description of the DB parameter group.`,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 64),
+					stringvalidator.RegexMatches(
+						regexache.MustCompile("^[a-zA-Z][a-zA-Z0-9]*(-[a-zA-Z0-9]+)*$"),
+						"",
+					),
+				},
+				Description: `The name of the DB parameter group. The name must be unique per customer
+					and per region.`,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[parametersData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Description: `A list of the parameters that comprise the DB parameter group.`,
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"influxdbv2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[influxDBv2ParametersData](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Description: `All the customer-modifiable InfluxDB v2 parameters in Timestream for InfluxDB.`,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flux_log_enabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+										},
+										Description: `Include option to show detailed logs for Flux queries.`,
+									},
+									"log_level": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.LogLevel](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+										Description: `Log output level. InfluxDB outputs log entries with severity levels
+											greater than or equal to the level specified.`,
+									},
+									"metrics_disabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+										},
+										Description: `Disable the HTTP /metrics endpoint which exposes internal InfluxDB metrics.`,
+									},
+									"no_tasks": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+										},
+										Description: `Disable the task scheduler. If problematic tasks prevent InfluxDB from
+											starting, use this option to start InfluxDB without scheduling or executing tasks.`,
+									},
+									"query_concurrency": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.RequiresReplace(),
+										},
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+										Description: `Number of queries allowed to execute concurrently. Setting to 0 allows
+											an unlimited number of concurrent queries.`,
+									},
+									"query_queue_size": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.RequiresReplace(),
+										},
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+										Description: `Maximum number of queries allowed in execution queue. When queue limit
+											is reached, new queries are rejected. Setting to 0 allows an unlimited number of
+											queries in the queue.`,
+									},
+									"tracing_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TracingType](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+										Description: `Enable tracing in InfluxDB and specifies the tracing type.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceDBParameterGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	var plan resourceDBParameterGroupData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := timestreaminfluxdb.CreateDbParameterGroupInput{}
+
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, &in)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateDbParameterGroup(ctx, &in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionCreating, ResNameDBParameterGroup, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.Id == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionCreating, ResNameDBParameterGroup, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	state := plan
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceDBParameterGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	var state resourceDBParameterGroupData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findDBParameterGroupByID(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionSetting, ResNameDBParameterGroup, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, output, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceDBParameterGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceDBParameterGroupData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Timestream for InfluxDB API has no operation to delete a DB parameter group.
+	resp.Diagnostics.AddWarning(
+		"Resource Not Deleted",
+		fmt.Sprintf("Timestream for InfluxDB DB parameter groups cannot be deleted. DB parameter group (%s) has been removed from Terraform state but still exists in AWS.", state.ID.ValueString()),
+	)
+}
+
+func (r *resourceDBParameterGroup) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The replaced DB parameter group isn't deleted and names are unique per Region,
+	// so creating the new DB parameter group fails unless its name also changes.
+	if len(response.RequiresReplace) > 0 && !response.RequiresReplace.Contains(path.Root(names.AttrName)) {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrName),
+			"Name Change Required",
+			"Changing the arguments of a Timestream for InfluxDB DB parameter group creates a new DB parameter group, "+
+				"but the existing one cannot be deleted and DB parameter group names must be unique per Region. Change name as well.",
+		)
+	}
+}
+
+func findDBParameterGroupByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbParameterGroupOutput, error) {
+	in := &timestreaminfluxdb.GetDbParameterGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	out, err := conn.GetDbParameterGroup(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Id == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceDBParameterGroupData struct {
+	ARN         types.String                                    `tfsdk:"arn"`
+	Description types.String                                    `tfsdk:"description"`
+	ID          types.String                                    `tfsdk:"id"`
+	Name        types.String                                    `tfsdk:"name"`
+	Parameters  fwtypes.ListNestedObjectValueOf[parametersData] `tfsdk:"parameters"`
+	Tags        types.Map                                       `tfsdk:"tags"`
+	TagsAll     types.Map                                       `tfsdk:"tags_all"`
+}
+
+type parametersData struct {
+	InfluxDBv2 fwtypes.ListNestedObjectValueOf[influxDBv2ParametersData] `tfsdk:"influxdbv2"`
+}
+
+var (
+	_ flex.Expander  = parametersData{}
+	_ flex.Flattener = &parametersData{}
+)
+
+func (m parametersData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	if !m.InfluxDBv2.IsNull() {
+		influxDBv2ParametersData := fwdiag.Must(m.InfluxDBv2.ToPtr(ctx))
+
+		var r awstypes.ParametersMemberInfluxDBv2
+		diags.Append(flex.Expand(ctx, influxDBv2ParametersData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *parametersData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.InfluxDBv2 = fwtypes.NewListNestedObjectValueOfNull[influxDBv2ParametersData](ctx)
+
+	switch t := v.(type) {
+	case *awstypes.ParametersMemberInfluxDBv2:
+		var model influxDBv2ParametersData
+		diags.Append(flex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.InfluxDBv2 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type influxDBv2ParametersData struct {
+	FluxLogEnabled   types.Bool                               `tfsdk:"flux_log_enabled"`
+	LogLevel         fwtypes.StringEnum[awstypes.LogLevel]    `tfsdk:"log_level"`
+	MetricsDisabled  types.Bool                               `tfsdk:"metrics_disabled"`
+	NoTasks          types.Bool                               `tfsdk:"no_tasks"`
+	QueryConcurrency types.Int64                              `tfsdk:"query_concurrency"`
+	QueryQueueSize   types.Int64                              `tfsdk:"query_queue_size"`
+	TracingType      fwtypes.StringEnum[awstypes.TracingType] `tfsdk:"tracing_type"`
+}
@@ -5,7 +5,11 @@ package timestreaminfluxdb
 
 // Exports for use in tests only.
 var (
-	ResourceDBInstance = newResourceDBInstance
+	ResourceDBCluster        = newResourceDBCluster
+	ResourceDBInstance       = newResourceDBInstance
+	ResourceDBParameterGroup = newResourceDBParameterGroup
 
-	FindDBInstanceByID = findDBInstanceByID
+	FindDBClusterByID        = findDBClusterByID
+	FindDBInstanceByID       = findDBInstanceByID
+	FindDBParameterGroupByID = findDBParameterGroupByID
 )
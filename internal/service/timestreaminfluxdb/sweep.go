@@ -17,12 +17,62 @@ import (
 )
 
 func RegisterSweepers() {
+	resource.AddTestSweepers("aws_timestreaminfluxdb_db_cluster", &resource.Sweeper{
+		Name: "aws_timestreaminfluxdb_db_cluster",
+		F:    sweepDBClusters,
+	})
+
 	resource.AddTestSweepers("aws_timestreaminfluxdb_db_instance", &resource.Sweeper{
 		Name: "aws_timestreaminfluxdb_db_instance",
 		F:    sweepDBInstances,
+		Dependencies: []string{
+			"aws_timestreaminfluxdb_db_cluster",
+		},
 	})
 }
 
+func sweepDBClusters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	input := &timestreaminfluxdb.ListDbClustersInput{}
+	conn := client.TimestreamInfluxDBClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := timestreaminfluxdb.NewListDbClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping TimestreamInfluxDB DB cluster sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing TimestreamInfluxDB DB clusters (%s): %w", region, err)
+		}
+
+		for _, v := range page.Items {
+			id := aws.ToString(v.Id)
+			log.Printf("[INFO] Deleting TimestreamInfluxDB DB cluster: %s", id)
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceDBCluster, client,
+				framework.NewAttribute(names.AttrID, id),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping TimestreamInfluxDB DB clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepDBInstances(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
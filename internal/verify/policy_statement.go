@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+const (
+	policyElementSid       = "Sid"
+	policyElementStatement = "Statement"
+	policyElementVersion   = "Version"
+	policyVersion          = "2012-10-17"
+)
+
+// PolicyWithStatement returns the policy with the statement identified by sid added, or replaced if it already exists.
+// The statement's Sid, if set, must match sid. Other elements of the policy are preserved.
+func PolicyWithStatement(policy, sid, statement string) (string, error) {
+	doc, statements, err := decodePolicyStatements(policy)
+	if err != nil {
+		return "", err
+	}
+
+	m, err := decodePolicyStatement(sid, statement)
+	if err != nil {
+		return "", err
+	}
+
+	found := false
+	for i, v := range statements {
+		if policyStatementSid(v) == sid {
+			statements[i] = m
+			found = true
+		}
+	}
+
+	if !found {
+		statements = append(statements, m)
+	}
+
+	return encodePolicyStatements(doc, statements)
+}
+
+// PolicyWithoutStatement returns the policy with the statement identified by sid removed,
+// along with the number of statements that remain.
+func PolicyWithoutStatement(policy, sid string) (string, int, error) {
+	doc, statements, err := decodePolicyStatements(policy)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var remaining []interface{}
+	for _, v := range statements {
+		if policyStatementSid(v) != sid {
+			remaining = append(remaining, v)
+		}
+	}
+
+	policy, err = encodePolicyStatements(doc, remaining)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return policy, len(remaining), nil
+}
+
+// PolicyStatementBySID returns the statement identified by sid, or false if the policy has no such statement.
+func PolicyStatementBySID(policy, sid string) (string, bool, error) {
+	_, statements, err := decodePolicyStatements(policy)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, v := range statements {
+		if policyStatementSid(v) == sid {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return "", false, err
+			}
+
+			return string(b), true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// PolicyStatementsEquivalent returns whether two statements, both identified by sid, grant the same permissions.
+func PolicyStatementsEquivalent(sid, s1, s2 string) bool {
+	p1, err := PolicyWithStatement("", sid, s1)
+	if err != nil {
+		return false
+	}
+
+	p2, err := PolicyWithStatement("", sid, s2)
+	if err != nil {
+		return false
+	}
+
+	return PolicyStringsEquivalent(p1, p2)
+}
+
+// PolicyStatementToSet returns the existing statement if the new statement is equivalent.
+// Otherwise, it returns the new statement. Either statement is normalized.
+func PolicyStatementToSet(sid, exist, new string) (string, error) {
+	// An invalid existing statement is simply replaced.
+	var existPolicy string
+	if strings.TrimSpace(exist) != "" {
+		if v, err := PolicyWithStatement("", sid, exist); err == nil {
+			existPolicy = v
+		}
+	}
+
+	newPolicy, err := PolicyWithStatement("", sid, new)
+	if err != nil {
+		return "", err
+	}
+
+	policy, err := PolicyToSet(existPolicy, newPolicy)
+	if err != nil {
+		return "", err
+	}
+
+	// Preserve the existing statement as written, e.g. without a Sid element, if it was kept.
+	statement := new
+	if existPolicy != "" && JSONStringsEqual(policy, existPolicy) {
+		statement = exist
+	}
+
+	return structure.NormalizeJsonString(statement)
+}
+
+// SuppressEquivalentPolicyStatementDiffs returns a difference suppression function that compares
+// two JSON strings representing IAM policy statements identified by the "sid" attribute and returns `true` if they are semantically equivalent.
+func SuppressEquivalentPolicyStatementDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return PolicyStatementsEquivalent(d.Get("sid").(string), old, new)
+}
+
+func decodePolicyStatements(policy string) (map[string]interface{}, []interface{}, error) {
+	doc := map[string]interface{}{}
+
+	if v := strings.TrimSpace(policy); v != "" {
+		if err := json.Unmarshal([]byte(v), &doc); err != nil {
+			return nil, nil, fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+		}
+	}
+
+	var statements []interface{}
+
+	switch v := doc[policyElementStatement].(type) {
+	case nil:
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	default:
+		return nil, nil, fmt.Errorf("policy (%s) has an invalid %s element", policy, policyElementStatement)
+	}
+
+	return doc, statements, nil
+}
+
+func decodePolicyStatement(sid, statement string) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+
+	if err := json.Unmarshal([]byte(statement), &m); err != nil {
+		return nil, fmt.Errorf("policy statement (%s) is invalid JSON: %w", statement, err)
+	}
+
+	if v, ok := m[policyElementSid]; ok && v != sid {
+		return nil, fmt.Errorf("policy statement %s (%v) does not match %q", policyElementSid, v, sid)
+	}
+
+	m[policyElementSid] = sid
+
+	return m, nil
+}
+
+func encodePolicyStatements(doc map[string]interface{}, statements []interface{}) (string, error) {
+	if statements == nil {
+		statements = []interface{}{}
+	}
+
+	doc[policyElementStatement] = statements
+
+	if _, ok := doc[policyElementVersion]; !ok {
+		doc[policyElementVersion] = policyVersion
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	// Some services require the Version element to come first.
+	return LegacyPolicyNormalize(string(b))
+}
+
+func policyStatementSid(statement interface{}) string {
+	if m, ok := statement.(map[string]interface{}); ok {
+		if v, ok := m[policyElementSid].(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
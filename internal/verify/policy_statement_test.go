@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+func TestPolicyWithStatement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy    string
+		sid       string
+		statement string
+		expected  string
+		wantErr   bool
+	}{
+		"empty policy": {
+			policy:    "",
+			sid:       "One",
+			statement: `{"Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*","Sid":"One"}]}`,
+		},
+		"append": {
+			policy:    `{"Version":"2012-10-17","Id":"Policy","Statement":[{"Sid":"Other","Effect":"Deny","Action":"*","Principal":"*","Resource":"*"}]}`,
+			sid:       "One",
+			statement: `{"Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Id":"Policy","Statement":[{"Action":"*","Effect":"Deny","Principal":"*","Resource":"*","Sid":"Other"},{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*","Sid":"One"}]}`,
+		},
+		"replace": {
+			policy:    `{"Version":"2012-10-17","Statement":[{"Sid":"One","Effect":"Deny","Action":"*","Principal":"*","Resource":"*"},{"Sid":"Other","Effect":"Deny","Action":"*","Principal":"*","Resource":"*"}]}`,
+			sid:       "One",
+			statement: `{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*","Sid":"One"},{"Action":"*","Effect":"Deny","Principal":"*","Resource":"*","Sid":"Other"}]}`,
+		},
+		"single statement object": {
+			policy:    `{"Version":"2012-10-17","Statement":{"Sid":"Other","Effect":"Deny","Action":"*","Principal":"*","Resource":"*"}}`,
+			sid:       "One",
+			statement: `{"Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Deny","Principal":"*","Resource":"*","Sid":"Other"},{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*","Sid":"One"}]}`,
+		},
+		"mismatched sid": {
+			policy:    "",
+			sid:       "One",
+			statement: `{"Sid":"Two","Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			wantErr:   true,
+		},
+		"invalid statement": {
+			policy:    "",
+			sid:       "One",
+			statement: `{`,
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PolicyWithStatement(testCase.policy, testCase.sid, testCase.statement)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("PolicyWithStatement() err %t, want %t: %v", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !PolicyStringsEquivalent(got, testCase.expected) {
+				t.Errorf("PolicyWithStatement() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestPolicyWithoutStatement(t *testing.T) {
+	t.Parallel()
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"},{"Sid":"Other","Effect":"Deny","Action":"*","Principal":"*","Resource":"*"}]}`
+
+	got, n, err := PolicyWithoutStatement(policy, "One")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if n != 1 {
+		t.Errorf("PolicyWithoutStatement() remaining = %d, want 1", n)
+	}
+
+	if _, ok, _ := PolicyStatementBySID(got, "One"); ok {
+		t.Errorf("PolicyWithoutStatement() = %s, still contains statement", got)
+	}
+
+	if _, ok, _ := PolicyStatementBySID(got, "Other"); !ok {
+		t.Errorf("PolicyWithoutStatement() = %s, missing other statement", got)
+	}
+
+	_, n, err = PolicyWithoutStatement(got, "Other")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if n != 0 {
+		t.Errorf("PolicyWithoutStatement() remaining = %d, want 0", n)
+	}
+}
+
+func TestPolicyStatementsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s1, s2   string
+		expected bool
+	}{
+		"missing sid": {
+			s1:       `{"Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			s2:       `{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			expected: true,
+		},
+		"single element list": {
+			s1:       `{"Effect":"Allow","Action":["s3:GetObject"],"Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Resource":"*"}`, //lintignore:AWSAT005
+			s2:       `{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Resource":"*"}`,     //lintignore:AWSAT005
+			expected: true,
+		},
+		"different actions": {
+			s1:       `{"Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			s2:       `{"Effect":"Allow","Action":"s3:PutObject","Principal":"*","Resource":"*"}`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := PolicyStatementsEquivalent("One", testCase.s1, testCase.s2), testCase.expected; got != want {
+				t.Errorf("PolicyStatementsEquivalent() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyStatementToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		exist, new string
+		expected   string
+		wantErr    bool
+	}{
+		"no existing statement": {
+			new:      `{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			expected: `{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*","Sid":"One"}`,
+		},
+		"equivalent": {
+			exist:    `{"Effect":"Allow","Action":["s3:GetObject"],"Principal":"*","Resource":"*"}`,
+			new:      `{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			expected: `{"Action":["s3:GetObject"],"Effect":"Allow","Principal":"*","Resource":"*"}`,
+		},
+		"different": {
+			exist:    `{"Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}`,
+			new:      `{"Sid":"One","Effect":"Allow","Action":"s3:PutObject","Principal":"*","Resource":"*"}`,
+			expected: `{"Action":"s3:PutObject","Effect":"Allow","Principal":"*","Resource":"*","Sid":"One"}`,
+		},
+		"invalid new statement": {
+			new:     `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PolicyStatementToSet("One", testCase.exist, testCase.new)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("PolicyStatementToSet() err %t, want %t", got, want)
+			}
+
+			if err == nil {
+				if got, want := got, testCase.expected; got != want {
+					t.Errorf("PolicyStatementToSet() = %s, want %s", got, want)
+				}
+			}
+		})
+	}
+}